@@ -30,6 +30,11 @@ func NewAccountCreditedDrawdown() *AccountCreditedDrawdown {
 	return creditDD
 }
 
+// setTag sets the tag of AccountCreditedDrawdown, which JSON does not hold
+func (creditDD *AccountCreditedDrawdown) setTag() {
+	creditDD.tag = TagAccountCreditedDrawdown
+}
+
 // Parse takes the input string and parses the AccountCreditedDrawdown values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
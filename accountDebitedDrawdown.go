@@ -35,6 +35,11 @@ func NewAccountDebitedDrawdown() *AccountDebitedDrawdown {
 	return debitDD
 }
 
+// setTag sets the tag of AccountDebitedDrawdown, which JSON does not hold
+func (debitDD *AccountDebitedDrawdown) setTag() {
+	debitDD.tag = TagAccountDebitedDrawdown
+}
+
 // Parse takes the input string and parses the AccountDebitedDrawdown values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
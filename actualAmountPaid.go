@@ -30,6 +30,11 @@ func NewActualAmountPaid() *ActualAmountPaid {
 	return aap
 }
 
+// setTag sets the tag of ActualAmountPaid, which JSON does not hold
+func (aap *ActualAmountPaid) setTag() {
+	aap.tag = TagActualAmountPaid
+}
+
 // Parse takes the input string and parses the ActualAmountPaid values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
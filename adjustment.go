@@ -36,6 +36,11 @@ func NewAdjustment() *Adjustment {
 	return adj
 }
 
+// setTag sets the tag of Adjustment, which JSON does not hold
+func (adj *Adjustment) setTag() {
+	adj.tag = TagAdjustment
+}
+
 // Parse takes the input string and parses the Adjustment values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
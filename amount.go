@@ -30,6 +30,11 @@ func NewAmount() *Amount {
 	return a
 }
 
+// setTag sets the tag of Amount, which JSON does not hold
+func (a *Amount) setTag() {
+	a.tag = TagAmount
+}
+
 // Parse takes the input string and parses the Amount value
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
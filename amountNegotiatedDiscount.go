@@ -30,6 +30,11 @@ func NewAmountNegotiatedDiscount() *AmountNegotiatedDiscount {
 	return nd
 }
 
+// setTag sets the tag of AmountNegotiatedDiscount, which JSON does not hold
+func (nd *AmountNegotiatedDiscount) setTag() {
+	nd.tag = TagAmountNegotiatedDiscount
+}
+
 // Parse takes the input string and parses the AmountNegotiatedDiscount values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -0,0 +1,40 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// BankTransferBuilder builds a bank transfer (BTR) FEDWireMessage and only sets the tags it may have. The
+// TypeSubType defaults to a basic funds transfer (1000).
+type BankTransferBuilder struct {
+	messageBuilder
+}
+
+// NewBankTransferBuilder returns a new BankTransferBuilder
+func NewBankTransferBuilder() *BankTransferBuilder {
+	return &BankTransferBuilder{newMessageBuilder(BankTransfer, builderTypeSubType(BankTransferFundsTransfer))}
+}
+
+// BankTransferTypeSubType is a TypeSubType {1510} a bank transfer may have
+type BankTransferTypeSubType builderTypeSubType
+
+var (
+	// BankTransferFundsTransfer is a basic funds transfer (1000)
+	BankTransferFundsTransfer = BankTransferTypeSubType{FundsTransfer, BasicFundsTransfer}
+	// BankTransferFundsReversal is a reversal of a funds transfer (1002)
+	BankTransferFundsReversal = BankTransferTypeSubType{FundsTransfer, ReversalTransfer}
+	// BankTransferFundsReversalPriorDay is a reversal of a prior day funds transfer (1008)
+	BankTransferFundsReversalPriorDay = BankTransferTypeSubType{FundsTransfer, ReversalPriorDayTransfer}
+	// BankTransferForeignTransfer is a basic foreign transfer (1500)
+	BankTransferForeignTransfer = BankTransferTypeSubType{ForeignTransfer, BasicFundsTransfer}
+	// BankTransferForeignReversal is a reversal of a foreign transfer (1502)
+	BankTransferForeignReversal = BankTransferTypeSubType{ForeignTransfer, ReversalTransfer}
+	// BankTransferForeignReversalPriorDay is a reversal of a prior day foreign transfer (1508)
+	BankTransferForeignReversalPriorDay = BankTransferTypeSubType{ForeignTransfer, ReversalPriorDayTransfer}
+	// BankTransferSettlementTransfer is a basic settlement transfer (1600)
+	BankTransferSettlementTransfer = BankTransferTypeSubType{SettlementTransfer, BasicFundsTransfer}
+	// BankTransferSettlementReversal is a reversal of a settlement transfer (1602)
+	BankTransferSettlementReversal = BankTransferTypeSubType{SettlementTransfer, ReversalTransfer}
+	// BankTransferSettlementReversalPriorDay is a reversal of a prior day settlement transfer (1608)
+	BankTransferSettlementReversalPriorDay = BankTransferTypeSubType{SettlementTransfer, ReversalPriorDayTransfer}
+)
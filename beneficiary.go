@@ -30,6 +30,11 @@ func NewBeneficiary() *Beneficiary {
 	return ben
 }
 
+// setTag sets the tag of Beneficiary, which JSON does not hold
+func (ben *Beneficiary) setTag() {
+	ben.tag = TagBeneficiary
+}
+
 // Parse takes the input string and parses the Beneficiary values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -78,6 +83,9 @@ func (ben *Beneficiary) Validate() error {
 	if err := ben.isAlphanumeric(ben.Personal.Identifier); err != nil {
 		return fieldError("Identifier", err, ben.Personal.Identifier)
 	}
+	if err := ben.isIdentifier(ben.Personal.IdentificationCode, ben.Personal.Identifier); err != nil {
+		return fieldError("Identifier", err, ben.Personal.Identifier)
+	}
 	if err := ben.isAlphanumeric(ben.Personal.Name); err != nil {
 		return fieldError("Name", err, ben.Personal.Name)
 	}
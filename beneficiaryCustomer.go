@@ -30,6 +30,11 @@ func NewBeneficiaryCustomer() *BeneficiaryCustomer {
 	return bc
 }
 
+// setTag sets the tag of BeneficiaryCustomer, which JSON does not hold
+func (bc *BeneficiaryCustomer) setTag() {
+	bc.tag = TagBeneficiaryCustomer
+}
+
 // Parse takes the input string and parses the BeneficiaryCustomer values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
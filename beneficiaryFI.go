@@ -30,6 +30,11 @@ func NewBeneficiaryFI() *BeneficiaryFI {
 	return bfi
 }
 
+// setTag sets the tag of BeneficiaryFI, which JSON does not hold
+func (bfi *BeneficiaryFI) setTag() {
+	bfi.tag = TagBeneficiaryFI
+}
+
 // Parse takes the input string and parses the BeneficiaryFI values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -88,6 +93,9 @@ func (bfi *BeneficiaryFI) Validate() error {
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Identifier); err != nil {
 		return fieldError("Identifier", err, bfi.FinancialInstitution.Identifier)
 	}
+	if err := bfi.isIdentifier(bfi.FinancialInstitution.IdentificationCode, bfi.FinancialInstitution.Identifier); err != nil {
+		return fieldError("Identifier", err, bfi.FinancialInstitution.Identifier)
+	}
 	if err := bfi.isAlphanumeric(bfi.FinancialInstitution.Name); err != nil {
 		return fieldError("Name", err, bfi.FinancialInstitution.Name)
 	}
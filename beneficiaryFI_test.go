@@ -173,3 +173,33 @@ func TestBeneficiaryFITagError(t *testing.T) {
 		}
 	}
 }
+
+// TestBeneficiaryFIFEDRoutingNumber validates a BeneficiaryFI Identifier is a routing number when the
+// IdentificationCode is FEDRoutingNumber
+func TestBeneficiaryFIFEDRoutingNumber(t *testing.T) {
+	bfi := mockBeneficiaryFI()
+	bfi.FinancialInstitution.IdentificationCode = FEDRoutingNumber
+	bfi.FinancialInstitution.Identifier = "121042882"
+	if err := bfi.Validate(); err != nil {
+		t.Errorf("%T: %s", err, err)
+	}
+	bfi.FinancialInstitution.Identifier = "121042883"
+	if err := bfi.Validate(); !base.Match(err, ErrRoutingNumber) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestBeneficiaryFISWIFTBankIdentifierCode validates a BeneficiaryFI Identifier is a BIC when the
+// IdentificationCode is SWIFTBankIdentifierCode
+func TestBeneficiaryFISWIFTBankIdentifierCode(t *testing.T) {
+	bfi := mockBeneficiaryFI()
+	bfi.FinancialInstitution.IdentificationCode = SWIFTBankIdentifierCode
+	bfi.FinancialInstitution.Identifier = "CITIUS33XXX"
+	if err := bfi.Validate(); err != nil {
+		t.Errorf("%T: %s", err, err)
+	}
+	bfi.FinancialInstitution.Identifier = "CITI US 33"
+	if err := bfi.Validate(); !base.Match(err, ErrBIC) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
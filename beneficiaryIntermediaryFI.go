@@ -30,6 +30,11 @@ func NewBeneficiaryIntermediaryFI() *BeneficiaryIntermediaryFI {
 	return bifi
 }
 
+// setTag sets the tag of BeneficiaryIntermediaryFI, which JSON does not hold
+func (bifi *BeneficiaryIntermediaryFI) setTag() {
+	bifi.tag = TagBeneficiaryIntermediaryFI
+}
+
 // Parse takes the input string and parses the ReceiverDepositoryInstitution values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -84,6 +89,9 @@ func (bifi *BeneficiaryIntermediaryFI) Validate() error {
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Identifier); err != nil {
 		return fieldError("Identifier", err, bifi.FinancialInstitution.Identifier)
 	}
+	if err := bifi.isIdentifier(bifi.FinancialInstitution.IdentificationCode, bifi.FinancialInstitution.Identifier); err != nil {
+		return fieldError("Identifier", err, bifi.FinancialInstitution.Identifier)
+	}
 	if err := bifi.isAlphanumeric(bifi.FinancialInstitution.Name); err != nil {
 		return fieldError("Name", err, bifi.FinancialInstitution.Name)
 	}
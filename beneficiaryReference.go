@@ -30,6 +30,11 @@ func NewBeneficiaryReference() *BeneficiaryReference {
 	return br
 }
 
+// setTag sets the tag of BeneficiaryReference, which JSON does not hold
+func (br *BeneficiaryReference) setTag() {
+	br.tag = TagBeneficiaryReference
+}
+
 // Parse takes the input string and parses the BeneficiaryReference values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -162,3 +162,17 @@ func TestBeneficiaryTagError(t *testing.T) {
 		}
 	}
 }
+
+// TestBeneficiaryIdentifierIBAN validates a Beneficiary account number which looks like an IBAN is an IBAN
+func TestBeneficiaryIdentifierIBAN(t *testing.T) {
+	ben := mockBeneficiary()
+	ben.Personal.IdentificationCode = DemandDepositAccountNumber
+	ben.Personal.Identifier = "DE89370400440532013000"
+	if err := ben.Validate(); err != nil {
+		t.Errorf("%T: %s", err, err)
+	}
+	ben.Personal.Identifier = "DE89370400440532013001"
+	if err := ben.Validate(); !base.Match(err, ErrIBAN) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+//go:generate go run ./internal/buildergen
+
+// builderTypeSubType is the TypeCode and SubTypeCode of TypeSubType {1510}. Each builder has its own type of
+// builderTypeSubType so it may only be set to the type and subtype codes legal for its business function code.
+type builderTypeSubType struct {
+	typeCode, subTypeCode string
+}
+
+// messageBuilder holds the FEDWireMessage of a business function code builder, such as CustomerTransferBuilder.
+// The mandatory tags are created with defaults for the business function code, so only the values particular
+// to a FEDWireMessage need to be set. Mandatory tags are replaced rather than modified so a FEDWireMessage
+// returned by build is not changed by later calls.
+type messageBuilder struct {
+	fwm FEDWireMessage
+}
+
+func newMessageBuilder(businessFunctionCode string, typeSubType builderTypeSubType) messageBuilder {
+	b := messageBuilder{fwm: NewFEDWireMessage()}
+	fwm := &b.fwm
+	fwm.SetSenderSupplied(NewSenderSupplied())
+	fwm.SetInputMessageAccountabilityData(NewInputMessageAccountabilityData())
+	fwm.SetAmount(NewAmount())
+	fwm.SetSenderDepositoryInstitution(NewSenderDepositoryInstitution())
+	fwm.SetReceiverDepositoryInstitution(NewReceiverDepositoryInstitution())
+	bfc := NewBusinessFunctionCode()
+	bfc.BusinessFunctionCode = businessFunctionCode
+	bfc.TransactionTypeCode = "   "
+	fwm.SetBusinessFunctionCode(bfc)
+	b.typeSubType(typeSubType)
+	return b
+}
+
+func (b *messageBuilder) userRequestCorrelation(userRequestCorrelation string) {
+	ss := *b.fwm.SenderSupplied
+	ss.UserRequestCorrelation = userRequestCorrelation
+	b.fwm.SetSenderSupplied(&ss)
+}
+
+func (b *messageBuilder) testProductionCode(testProductionCode string) {
+	ss := *b.fwm.SenderSupplied
+	ss.TestProductionCode = testProductionCode
+	b.fwm.SetSenderSupplied(&ss)
+}
+
+func (b *messageBuilder) typeSubType(typeSubType builderTypeSubType) {
+	tst := NewTypeSubType()
+	tst.TypeCode = typeSubType.typeCode
+	tst.SubTypeCode = typeSubType.subTypeCode
+	b.fwm.SetTypeSubType(tst)
+}
+
+func (b *messageBuilder) imad(cycleDate, source, sequenceNumber string) {
+	imad := NewInputMessageAccountabilityData()
+	imad.InputCycleDate = cycleDate
+	imad.InputSource = source
+	imad.InputSequenceNumber = sequenceNumber
+	b.fwm.SetInputMessageAccountabilityData(imad)
+}
+
+func (b *messageBuilder) amount(amount string) {
+	a := NewAmount()
+	a.Amount = amount
+	b.fwm.SetAmount(a)
+}
+
+func (b *messageBuilder) senderDepositoryInstitution(abaNumber, shortName string) {
+	sdi := NewSenderDepositoryInstitution()
+	sdi.SenderABANumber = abaNumber
+	sdi.SenderShortName = shortName
+	b.fwm.SetSenderDepositoryInstitution(sdi)
+}
+
+func (b *messageBuilder) receiverDepositoryInstitution(abaNumber, shortName string) {
+	rdi := NewReceiverDepositoryInstitution()
+	rdi.ReceiverABANumber = abaNumber
+	rdi.ReceiverShortName = shortName
+	b.fwm.SetReceiverDepositoryInstitution(rdi)
+}
+
+// build returns the FEDWireMessage, along with ValidationErrors of every problem found with it when it is not
+// valid
+func (b *messageBuilder) build() (FEDWireMessage, error) {
+	fwm := b.fwm
+	if errs := fwm.ValidateAll(); len(errs) > 0 {
+		return fwm, errs
+	}
+	return fwm, nil
+}
@@ -0,0 +1,260 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+// TestCustomerTransferBuilder builds a valid customer transfer and writes it
+func TestCustomerTransferBuilder(t *testing.T) {
+	fwm, err := NewCustomerTransferBuilder().
+		UserRequestCorrelation("User Req").
+		InputMessageAccountabilityData(time.Now().Format("20060102"), "Source08", "000001").
+		Amount("000001234567").
+		SenderDepositoryInstitution("121042882", "Wells Fargo NA").
+		ReceiverDepositoryInstitution("231380104", "Citadel").
+		SenderReference(mockSenderReference()).
+		Beneficiary(mockBeneficiary()).
+		Originator(mockOriginator()).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fwm.BusinessFunctionCode.BusinessFunctionCode != CustomerTransfer {
+		t.Errorf("BusinessFunctionCode=%q", fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	if fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode != "1000" {
+		t.Errorf("TypeSubType=%q", fwm.TypeSubType.String())
+	}
+
+	file := NewFile()
+	file.AddFEDWireMessage(fwm)
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).Write(file); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestCustomerTransferBuilder__missingTags returns every problem with the FEDWireMessage
+func TestCustomerTransferBuilder__missingTags(t *testing.T) {
+	_, err := NewCustomerTransferBuilder().
+		UserRequestCorrelation("User Req").
+		InputMessageAccountabilityData(time.Now().Format("20060102"), "Source08", "000001").
+		Amount("000001234567").
+		SenderDepositoryInstitution("121042882", "Wells Fargo NA").
+		ReceiverDepositoryInstitution("231380104", "Citadel").
+		Build()
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("%T: %v", err, err)
+	}
+	var beneficiary, originator bool
+	for i := range errs {
+		beneficiary = beneficiary || errs[i].Tag == TagBeneficiary
+		originator = originator || errs[i].Field == "Originator or OriginatorFI"
+	}
+	if !beneficiary || !originator {
+		t.Errorf("expected Beneficiary and Originator errors: %v", errs)
+	}
+}
+
+// TestCustomerTransferBuilder__copy ensures a built FEDWireMessage does not change as the builder is used again
+func TestCustomerTransferBuilder__copy(t *testing.T) {
+	b := NewCustomerTransferBuilder().
+		UserRequestCorrelation("User Req").
+		InputMessageAccountabilityData(time.Now().Format("20060102"), "Source08", "000001").
+		Amount("000001234567").
+		SenderDepositoryInstitution("121042882", "Wells Fargo NA").
+		ReceiverDepositoryInstitution("231380104", "Citadel").
+		Beneficiary(mockBeneficiary()).
+		Originator(mockOriginator())
+	first, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := b.InputMessageAccountabilityData(time.Now().Format("20060102"), "Source08", "000002").
+		Amount("000000000100").
+		UserRequestCorrelation("Next Req").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.InputMessageAccountabilityData.InputSequenceNumber != "000001" || first.Amount.Amount != "000001234567" ||
+		first.SenderSupplied.UserRequestCorrelation != "User Req" {
+		t.Errorf("first FEDWireMessage changed: %v %v %v", first.InputMessageAccountabilityData, first.Amount, first.SenderSupplied)
+	}
+	if second.InputMessageAccountabilityData.InputSequenceNumber != "000002" || second.Amount.Amount != "000000000100" {
+		t.Errorf("unexpected second FEDWireMessage: %v %v", second.InputMessageAccountabilityData, second.Amount)
+	}
+}
+
+// TestCustomerTransferPlusBuilder builds a customer transfer plus with structured remittance
+func TestCustomerTransferPlusBuilder(t *testing.T) {
+	li := NewLocalInstrument()
+	li.LocalInstrumentCode = RemittanceInformationStructured
+	_, err := NewCustomerTransferPlusBuilder().
+		UserRequestCorrelation("User Req").
+		InputMessageAccountabilityData(time.Now().Format("20060102"), "Source08", "000001").
+		Amount("000001234567").
+		SenderDepositoryInstitution("121042882", "Wells Fargo NA").
+		ReceiverDepositoryInstitution("231380104", "Citadel").
+		LocalInstrument(li).
+		Beneficiary(mockBeneficiary()).
+		Originator(mockOriginator()).
+		RemittanceOriginator(mockRemittanceOriginator()).
+		RemittanceBeneficiary(mockRemittanceBeneficiary()).
+		PrimaryRemittanceDocument(mockPrimaryRemittanceDocument()).
+		ActualAmountPaid(mockActualAmountPaid()).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestBankTransferBuilder builds a bank transfer with the default TypeSubType
+func TestBankTransferBuilder(t *testing.T) {
+	fwm, err := NewBankTransferBuilder().
+		UserRequestCorrelation("User Req").
+		InputMessageAccountabilityData(time.Now().Format("20060102"), "Source08", "000001").
+		Amount("000001234567").
+		SenderDepositoryInstitution("121042882", "Wells Fargo NA").
+		ReceiverDepositoryInstitution("231380104", "Citadel").
+		Beneficiary(mockBeneficiary()).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fwm.BusinessFunctionCode.BusinessFunctionCode != BankTransfer {
+		t.Errorf("BusinessFunctionCode=%q", fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+}
+
+// TestDrawdownRequestBuilder builds a drawdown payment
+func TestDrawdownRequestBuilder(t *testing.T) {
+	fwm, err := NewDrawdownRequestBuilder().
+		UserRequestCorrelation("User Req").
+		InputMessageAccountabilityData(time.Now().Format("20060102"), "Source08", "000001").
+		Amount("000001234567").
+		SenderDepositoryInstitution("121042882", "Wells Fargo NA").
+		ReceiverDepositoryInstitution("231380104", "Citadel").
+		Beneficiary(mockBeneficiary()).
+		Originator(mockOriginator()).
+		AccountDebitedDrawdown(mockAccountDebitedDrawdown()).
+		AccountCreditedDrawdown(mockAccountCreditedDrawdown()).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fwm.TypeSubType.SubTypeCode != FundsTransferRequestCredit {
+		t.Errorf("SubTypeCode=%q", fwm.TypeSubType.SubTypeCode)
+	}
+}
+
+// TestFEDFundsSoldBuilder builds a Fed funds sold settlement transfer
+func TestFEDFundsSoldBuilder(t *testing.T) {
+	fwm, err := NewFEDFundsSoldBuilder().
+		UserRequestCorrelation("User Req").
+		InputMessageAccountabilityData(time.Now().Format("20060102"), "Source08", "000001").
+		Amount("000001234567").
+		SenderDepositoryInstitution("121042882", "Wells Fargo NA").
+		ReceiverDepositoryInstitution("231380104", "Citadel").
+		Beneficiary(mockBeneficiary()).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fwm.TypeSubType.TypeCode != SettlementTransfer {
+		t.Errorf("TypeCode=%q", fwm.TypeSubType.TypeCode)
+	}
+}
+
+// TestServiceMessageBuilder builds a non-value service message
+func TestServiceMessageBuilder(t *testing.T) {
+	fwm, err := NewServiceMessageBuilder().
+		UserRequestCorrelation("User Req").
+		InputMessageAccountabilityData(time.Now().Format("20060102"), "Source08", "000001").
+		SenderDepositoryInstitution("121042882", "Wells Fargo NA").
+		ReceiverDepositoryInstitution("231380104", "Citadel").
+		Beneficiary(mockBeneficiary()).
+		ServiceMessage(mockServiceMessage()).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fwm.Amount.Amount != "000000000000" {
+		t.Errorf("Amount=%q", fwm.Amount.Amount)
+	}
+}
+
+// TestBuilder__typeSubType ensures every TypeSubType a builder may set is legal for its business function code
+func TestBuilder__typeSubType(t *testing.T) {
+	var fwms []FEDWireMessage
+	for _, tst := range []BankTransferTypeSubType{
+		BankTransferFundsTransfer, BankTransferFundsReversal, BankTransferFundsReversalPriorDay,
+		BankTransferForeignTransfer, BankTransferForeignReversal, BankTransferForeignReversalPriorDay,
+		BankTransferSettlementTransfer, BankTransferSettlementReversal, BankTransferSettlementReversalPriorDay,
+	} {
+		fwms = append(fwms, NewBankTransferBuilder().TypeSubType(tst).fwm)
+	}
+	for _, tst := range []CustomerTransferTypeSubType{
+		CustomerTransferFundsTransfer, CustomerTransferFundsReversal, CustomerTransferFundsReversalPriorDay,
+		CustomerTransferForeignTransfer, CustomerTransferForeignReversal, CustomerTransferForeignReversalPriorDay,
+		CustomerTransferSettlementTransfer, CustomerTransferSettlementReversal, CustomerTransferSettlementReversalPriorDay,
+	} {
+		fwms = append(fwms, NewCustomerTransferBuilder().TypeSubType(tst).fwm)
+	}
+	for _, tst := range []CustomerTransferPlusTypeSubType{
+		CustomerTransferPlusFundsTransfer, CustomerTransferPlusFundsRequestReversal, CustomerTransferPlusFundsReversal,
+		CustomerTransferPlusFundsRequestReversalPriorDay, CustomerTransferPlusFundsReversalPriorDay,
+		CustomerTransferPlusForeignTransfer, CustomerTransferPlusForeignRequestReversal,
+		CustomerTransferPlusForeignReversal, CustomerTransferPlusForeignRequestReversalPriorDay,
+		CustomerTransferPlusForeignReversalPriorDay, CustomerTransferPlusSettlementTransfer,
+		CustomerTransferPlusSettlementRequestReversal, CustomerTransferPlusSettlementReversal,
+		CustomerTransferPlusSettlementRequestReversalPriorDay, CustomerTransferPlusSettlementReversalPriorDay,
+	} {
+		fwms = append(fwms, NewCustomerTransferPlusBuilder().TypeSubType(tst).fwm)
+	}
+	for _, tst := range []DrawdownRequestTypeSubType{DrawdownRequestFundsPayment, DrawdownRequestSettlementPayment} {
+		fwms = append(fwms, NewDrawdownRequestBuilder().TypeSubType(tst).fwm)
+	}
+	for _, tst := range []FEDFundsSoldTypeSubType{
+		FEDFundsSoldSettlementTransfer, FEDFundsSoldSettlementReversal, FEDFundsSoldSettlementReversalPriorDay,
+	} {
+		fwms = append(fwms, NewFEDFundsSoldBuilder().TypeSubType(tst).fwm)
+	}
+	for _, tst := range []ServiceMessageTypeSubType{
+		ServiceMessageFundsRequestReversal, ServiceMessageFundsRequestReversalPriorDay,
+		ServiceMessageFundsRefusalRequestCredit, ServiceMessageFunds, ServiceMessageForeignRequestReversal,
+		ServiceMessageForeignRequestReversalPriorDay, ServiceMessageForeign, ServiceMessageSettlementRequestReversal,
+		ServiceMessageSettlementRequestReversalPriorDay, ServiceMessageSettlementRefusalRequestCredit,
+		ServiceMessageSettlement,
+	} {
+		fwms = append(fwms, NewServiceMessageBuilder().TypeSubType(tst).fwm)
+	}
+
+	for i := range fwms {
+		var err error
+		switch fwms[i].BusinessFunctionCode.BusinessFunctionCode {
+		case BankTransfer:
+			err = fwms[i].isBankTransferValid()
+		case CustomerTransfer:
+			err = fwms[i].isCustomerTransferValid()
+		case CustomerTransferPlus:
+			err = fwms[i].isCustomerTransferPlusValid()
+		case DrawDownRequest:
+			err = fwms[i].isDrawdownRequestValid()
+		case FEDFundsSold:
+			err = fwms[i].isFEDFundsSoldValid()
+		case BFCServiceMessage:
+			err = fwms[i].isServiceMessageValid()
+		}
+		if err != nil {
+			t.Errorf("%s %s: %v", fwms[i].BusinessFunctionCode.BusinessFunctionCode, fwms[i].TypeSubType.String(), err)
+		}
+	}
+}
@@ -32,6 +32,11 @@ func NewBusinessFunctionCode() *BusinessFunctionCode {
 	return bfc
 }
 
+// setTag sets the tag of BusinessFunctionCode, which JSON does not hold
+func (bfc *BusinessFunctionCode) setTag() {
+	bfc.tag = TagBusinessFunctionCode
+}
+
 // Parse takes the input string and parses the BusinessFunctionCode values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
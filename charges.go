@@ -46,6 +46,11 @@ func NewCharges() *Charges {
 	return c
 }
 
+// setTag sets the tag of Charges, which JSON does not hold
+func (c *Charges) setTag() {
+	c.tag = TagCharges
+}
+
 // Parse takes the input string and parses the Charges values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
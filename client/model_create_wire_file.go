@@ -12,6 +12,8 @@ package openapi
 // CreateWireFile struct for CreateWireFile
 type CreateWireFile struct {
 	// File ID
-	ID             string         `json:"ID,omitempty"`
-	FedWireMessage FedWireMessage `json:"fedWireMessage"`
+	ID              string           `json:"ID,omitempty"`
+	FedWireMessages []FedWireMessage `json:"fedWireMessages,omitempty"`
+	// A single FEDWireMessage, use fedWireMessages instead. It is a pointer so it is left out when not set.
+	FedWireMessage *FedWireMessage `json:"fedWireMessage,omitempty"`
 }
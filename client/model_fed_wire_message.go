@@ -73,4 +73,5 @@ type FedWireMessage struct {
 	SecondaryRemittanceDocument     SecondaryRemittanceDocument     `json:"secondaryRemittanceDocument,omitempty"`
 	RemittanceFreeText              RemittanceFreeText              `json:"remittanceFreeText,omitempty"`
 	ServiceMessage                  ServiceMessage                  `json:"serviceMessage,omitempty"`
+	UnknownTags                     []UnknownTag                    `json:"unknownTags,omitempty"`
 }
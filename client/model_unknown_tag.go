@@ -0,0 +1,20 @@
+/*
+ * WIRE API
+ *
+ * Moov WIRE implements an HTTP API for creating, parsing and validating WIRE files.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// UnknownTag struct for UnknownTag
+type UnknownTag struct {
+	// Tag which was not recognised
+	Tag string `json:"tag"`
+	// Text following the tag, as read
+	Value string `json:"value,omitempty"`
+	// Number of tags preceding the tag in the FEDWireMessage
+	Position int32 `json:"position,omitempty"`
+}
@@ -0,0 +1,24 @@
+/*
+ * WIRE API
+ *
+ * Moov WIRE implements an HTTP API for creating, parsing and validating WIRE files.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ValidationError struct for ValidationError
+type ValidationError struct {
+	// Index of the FEDWireMessage within the File
+	MessageIndex int32 `json:"messageIndex,omitempty"`
+	// Tag with the problem
+	Tag string `json:"tag,omitempty"`
+	// Name of the field with the problem
+	Field string `json:"field,omitempty"`
+	// Offending value
+	Value string `json:"value,omitempty"`
+	// Rule which was broken
+	Rule string `json:"rule"`
+}
@@ -0,0 +1,17 @@
+/*
+ * WIRE API
+ *
+ * Moov WIRE implements an HTTP API for creating, parsing and validating WIRE files.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ValidationErrors struct for ValidationErrors
+type ValidationErrors struct {
+	// Every problem found, at most one per tag as a tag stops at its first invalid field
+	Error  string            `json:"error"`
+	Errors []ValidationError `json:"errors"`
+}
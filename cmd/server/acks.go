@@ -0,0 +1,181 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/wire"
+
+	"github.com/go-kit/kit/log"
+	"github.com/gorilla/mux"
+)
+
+var (
+	errNoOutputMessageAccountabilityData = errors.New("acknowledgement has no OutputMessageAccountabilityData (OMAD)")
+	errNoIMAD                            = errors.New("acknowledgement has no InputMessageAccountabilityData (IMAD)")
+	errIMADNotFound                      = errors.New("no FEDWireMessage with IMAD found")
+)
+
+// messageAcknowledgement is the output message the Fedwire Funds Service returned for a sent FEDWireMessage
+type messageAcknowledgement struct {
+	// IMAD identifies the acknowledged FEDWireMessage
+	IMAD                            string                                `json:"imad"`
+	MessageDisposition              *wire.MessageDisposition              `json:"messageDisposition,omitempty"`
+	ReceiptTimeStamp                *wire.ReceiptTimeStamp                `json:"receiptTimeStamp,omitempty"`
+	OutputMessageAccountabilityData *wire.OutputMessageAccountabilityData `json:"outputMessageAccountabilityData"`
+	// ErrorWire is why the Fedwire Funds Service rejected the FEDWireMessage, nil when it was accepted
+	ErrorWire *wire.ErrorWire `json:"errorWire,omitempty"`
+	// Timestamp is when the acknowledgement was ingested
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// rejected returns true when the Fedwire Funds Service rejected the FEDWireMessage
+func (ack *messageAcknowledgement) rejected() bool {
+	return ack.ErrorWire != nil && strings.TrimSpace(ack.ErrorWire.ErrorCategory) != ""
+}
+
+// acknowledgementResult is the outcome of ingesting one acknowledgement
+type acknowledgementResult struct {
+	IMAD   string `json:"imad,omitempty"`
+	FileID string `json:"fileId,omitempty"`
+	// Status is the status of the File once the acknowledgement was ingested
+	Status string `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+	// ParseErrors locate the problems reading the acknowledgement
+	ParseErrors []parseError `json:"parseErrors,omitempty"`
+}
+
+func addAcknowledgementRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository) {
+	r.Methods("POST").Path("/acknowledgements").HandlerFunc(ingestAcknowledgements(logger, repo))
+}
+
+// ingestAcknowledgements reads the output messages of the Fedwire Funds Service, each holding the IMAD of a sent
+// FEDWireMessage along with its OMAD, receipt timestamp and, when rejected, ErrorWire {1130}. A File is
+// acknowledged once every FEDWireMessage is accepted, or rejected once any is rejected.
+func ingestAcknowledgements(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w = wrapResponseWriter(logger, w, r)
+
+		requestID, actor := moovhttp.GetRequestID(r), moovhttp.GetUserID(r)
+
+		var results []acknowledgementResult
+		reader := wire.NewReader(r.Body)
+		for {
+			fwm, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			if fwm == nil {
+				moovhttp.Problem(w, err)
+				return
+			}
+			var result acknowledgementResult
+			if err != nil {
+				result.Error = err.Error()
+				result.ParseErrors = parseErrors(err)
+			} else {
+				result = ingestAcknowledgement(repo, fwm, actor, time.Now())
+			}
+			if result.Error != "" {
+				logger.Log("acknowledgements", fmt.Sprintf("problem ingesting acknowledgement of IMAD=%s: %s", result.IMAD, result.Error), "requestId", requestID)
+			} else {
+				logger.Log("acknowledgements", fmt.Sprintf("file=%s is %s after IMAD=%s", result.FileID, result.Status, result.IMAD), "requestId", requestID)
+			}
+			results = append(results, result)
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(results)
+	}
+}
+
+// ingestAcknowledgement records the acknowledgement fwm against the File holding the FEDWireMessage it
+// acknowledges
+func ingestAcknowledgement(repo WireFileRepository, fwm *wire.FEDWireMessage, actor string, now time.Time) acknowledgementResult {
+	imad, _ := messageKeys(fwm)
+	result := acknowledgementResult{IMAD: imad}
+	fail := func(err error) acknowledgementResult {
+		result.Error = err.Error()
+		return result
+	}
+	if imad == "" {
+		return fail(errNoIMAD)
+	}
+	if fwm.OutputMessageAccountabilityData == nil {
+		return fail(errNoOutputMessageAccountabilityData)
+	}
+
+	fileId, err := repo.getFileIdByIMAD(imad)
+	if err != nil {
+		return fail(err)
+	}
+	if fileId == "" {
+		return fail(errIMADNotFound)
+	}
+	result.FileID = fileId
+	file, err := repo.getFile(fileId)
+	if err != nil {
+		return fail(err)
+	}
+	if file == nil {
+		return fail(errIMADNotFound)
+	}
+	ack := messageAcknowledgement{
+		IMAD:                            imad,
+		MessageDisposition:              fwm.MessageDisposition,
+		ReceiptTimeStamp:                fwm.ReceiptTimeStamp,
+		OutputMessageAccountabilityData: fwm.OutputMessageAccountabilityData,
+		ErrorWire:                       fwm.ErrorWire,
+		Timestamp:                       now.UTC(),
+	}
+	status, err := repo.updateFileStatus(fileId, func(status *fileStatus) error {
+		if status.Status != statusSent {
+			return &transitionError{From: status.Status, To: "acknowledged"}
+		}
+		status.Acknowledgements = append(status.Acknowledgements, ack)
+
+		if to, reason := acknowledgedStatus(file, status); to != "" {
+			if err := status.transition(to, actor, now); err != nil {
+				return err
+			}
+			status.Transitions[len(status.Transitions)-1].Reason = reason
+		}
+		return nil
+	})
+	if err != nil {
+		return fail(err)
+	}
+	result.Status = status.Status
+	return result
+}
+
+// acknowledgedStatus returns rejected, along with the ErrorWire, once any FEDWireMessage of file is rejected and
+// acknowledged once every FEDWireMessage is accepted. An empty status is returned while FEDWireMessages are
+// waiting for an acknowledgement.
+func acknowledgedStatus(file *wire.File, status *fileStatus) (string, string) {
+	accepted := make(map[string]bool)
+	for i := range status.Acknowledgements {
+		ack := &status.Acknowledgements[i]
+		if ack.rejected() {
+			return statusRejected, strings.TrimSpace(fmt.Sprintf("%s%s %s", ack.ErrorWire.ErrorCategory, ack.ErrorWire.ErrorCode, ack.ErrorWire.ErrorDescription))
+		}
+		accepted[ack.IMAD] = true
+	}
+	for i := range file.FEDWireMessages {
+		if imad, _ := messageKeys(&file.FEDWireMessages[i]); !accepted[imad] {
+			return "", ""
+		}
+	}
+	return statusAcknowledged, ""
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/wire"
+
+	"github.com/go-kit/kit/log"
+	"github.com/gorilla/mux"
+)
+
+// mockAcknowledgement returns the output message of the sample CustomerTransfer, rejected with errorWire when
+// it is not empty
+func mockAcknowledgement(t *testing.T, errorWire string) string {
+	bs, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ack := "{1100}30P N\n{1110}04101230FT03\n{1120}20190410ABCD1234000001041012300FT1\n"
+	if errorWire != "" {
+		ack += fmt.Sprintf("{1130}%-39s\n", errorWire)
+	}
+	return ack + string(bs)
+}
+
+func TestAcknowledgements(t *testing.T) {
+	for _, tc := range []struct {
+		errorWire, status string
+	}{
+		{"", statusAcknowledged},
+		{"E301Invalid amount", statusRejected},
+	} {
+		repo := &memoryWireFileRepository{
+			files: make(map[string]*wire.File),
+		}
+		router := mux.NewRouter()
+		addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+		addAcknowledgementRoutes(log.NewNopLogger(), router, repo)
+
+		serve := func(path, body string) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("POST", path, strings.NewReader(body)))
+			w.Flush()
+			return w
+		}
+
+		f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := wire.NewWriter(&buf).Write(f); err != nil {
+			t.Fatal(err)
+		}
+		w := serve("/files/create", buf.String())
+		if w.Code != http.StatusCreated {
+			t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+		}
+		var created struct {
+			ID string `json:"id"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&created); err != nil {
+			t.Fatal(err)
+		}
+
+		// acknowledgements of a File which was not sent are not recorded
+		ack := mockAcknowledgement(t, tc.errorWire)
+		var results []acknowledgementResult
+		if err := json.NewDecoder(serve("/acknowledgements", ack).Body).Decode(&results); err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != 1 || results[0].Error == "" {
+			t.Errorf("unexpected results: %#v", results)
+		}
+
+		for _, step := range []string{"approve", "send"} {
+			if w := serve("/files/"+created.ID+"/"+step, ""); w.Code != http.StatusOK {
+				t.Fatalf("%s: bogus HTTP status: %d: %s", step, w.Code, w.Body.String())
+			}
+		}
+
+		w = serve("/acknowledgements", ack)
+		if w.Code != http.StatusOK {
+			t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+		}
+		results = nil
+		if err := json.NewDecoder(w.Body).Decode(&results); err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != 1 || results[0].FileID != created.ID || results[0].Status != tc.status || results[0].Error != "" {
+			t.Fatalf("unexpected results: %#v", results)
+		}
+
+		status, err := repo.getFileStatus(created.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(status.Acknowledgements) != 1 || status.Acknowledgements[0].OutputMessageAccountabilityData.OutputSequenceNumber != "000001" {
+			t.Errorf("unexpected acknowledgements: %#v", status.Acknowledgements)
+		}
+		last := status.Transitions[len(status.Transitions)-1]
+		if tc.errorWire != "" && !strings.HasPrefix(last.Reason, "E301") {
+			t.Errorf("unexpected transition: %#v", last)
+		}
+	}
+}
+
+func TestAcknowledgements__unmatched(t *testing.T) {
+	repo := &testWireFileRepository{}
+	imad := wire.NewInputMessageAccountabilityData()
+	imad.InputCycleDate, imad.InputSource, imad.InputSequenceNumber = "20190410", "Source08", "000001"
+	fwm := wire.NewFEDWireMessage()
+	fwm.SetInputMessageAccountabilityData(imad)
+	if result := ingestAcknowledgement(repo, &fwm, "", time.Now()); result.Error != errNoOutputMessageAccountabilityData.Error() {
+		t.Errorf("unexpected result: %#v", result)
+	}
+	fwm.SetOutputMessageAccountabilityData(wire.NewOutputMessageAccountabilityData())
+	if result := ingestAcknowledgement(repo, &fwm, "", time.Now()); result.Error != errIMADNotFound.Error() {
+		t.Errorf("unexpected result: %#v", result)
+	}
+}
+
+// TestAcknowledgements__resend validates the acknowledgement of a resend is recorded against its File
+func TestAcknowledgements__resend(t *testing.T) {
+	repo := &memoryWireFileRepository{
+		files: make(map[string]*wire.File),
+	}
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.ID = "resend"
+	f.FEDWireMessages[0].SenderSupplied.MessageDuplicationCode = wire.MessageDuplicationResend
+	_, err = repo.saveFileWithStatus(f, func(status *fileStatus) error {
+		for _, to := range []string{statusValidated, statusApproved, statusSent} {
+			if err := status.transition(to, "jane", time.Now()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ack, err := wire.NewReader(strings.NewReader(mockAcknowledgement(t, ""))).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := ingestAcknowledgement(repo, ack.FEDWireMessage(), "jane", time.Now())
+	if result.Error != "" || result.FileID != f.ID || result.Status != statusAcknowledged {
+		t.Errorf("unexpected result: %#v", result)
+	}
+}
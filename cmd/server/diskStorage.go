@@ -0,0 +1,249 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+
+	"github.com/moov-io/wire"
+	"github.com/moov-io/wire/internal/atomicfile"
+)
+
+var (
+	errInvalidFileID = errors.New("invalid Wire File ID")
+
+	// fileIDRegex matches File IDs which are safe to use as a filename
+	fileIDRegex = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9_.-]*$`)
+)
+
+// diskWireFileRepository is a WireFileRepository which stores each File in its own JSON document in a
+// directory. Documents are replaced atomically, so a crash leaves either the previous or the new File.
+type diskWireFileRepository struct {
+	mu    sync.Mutex
+	dir   string
+	index *messageIndex
+}
+
+// diskWireFile is the document a File is stored in. The File is read from its JSON, which holds every field of
+// the File and its FEDWireMessages, such as their IDs.
+type diskWireFile struct {
+	File *wire.File `json:"file"`
+	// FAIM is a plaintext FAIM copy of File for operators, empty when File does not validate or its FAIM text can
+	// not be read back. It is not read.
+	FAIM   string      `json:"faim,omitempty"`
+	Status *fileStatus `json:"status,omitempty"`
+}
+
+// newDiskWireFileRepository returns a diskWireFileRepository storing Files in dir, which is created when it does
+// not exist. The Files already stored in dir are indexed.
+func newDiskWireFileRepository(dir string) (*diskWireFileRepository, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	r := &diskWireFileRepository{
+		dir:   dir,
+		index: newMessageIndex(),
+	}
+	files, err := r.getFiles()
+	if err != nil {
+		return nil, err
+	}
+	for i := range files {
+		r.index.add(files[i])
+	}
+	return r, nil
+}
+
+func (r *diskWireFileRepository) path(fileId string) string {
+	return filepath.Join(r.dir, fileId+".json")
+}
+
+func (r *diskWireFileRepository) getFiles() ([]*wire.File, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	matches, err := filepath.Glob(filepath.Join(r.dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	var out []*wire.File
+	for i := range matches {
+		doc, err := readDiskWireFile(matches[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, doc.File)
+	}
+	return out, nil
+}
+
+func (r *diskWireFileRepository) getFile(fileId string) (*wire.File, error) {
+	if !fileIDRegex.MatchString(fileId) {
+		return nil, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	doc, err := readDiskWireFile(r.path(fileId))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc.File, nil
+}
+
+func (r *diskWireFileRepository) saveFile(file *wire.File) error {
+	_, err := r.saveFileWithStatus(file, nil)
+	return err
+}
+
+func (r *diskWireFileRepository) saveFileWithStatus(file *wire.File, update func(*fileStatus) error) (*fileStatus, error) {
+	if file.ID == "" {
+		return nil, errors.New("empty Wire File ID")
+	}
+	if !fileIDRegex.MatchString(file.ID) {
+		return nil, errInvalidFileID
+	}
+
+	doc := diskWireFile{File: file}
+	var buf bytes.Buffer
+	if err := wire.NewWriter(&buf).Write(file); err == nil {
+		// FAIM text which can not be read back, such as a tag of a FEDWireMessage created as JSON which does not
+		// parse, is not stored
+		if _, err := wire.NewReader(bytes.NewReader(buf.Bytes()), wire.PreserveUnknownTags(true)).Read(); err == nil {
+			doc.FAIM = buf.String()
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.index.check(file); err != nil {
+		return nil, err
+	}
+	// the status of the File is kept unless it is updated
+	if stored, err := readDiskWireFile(r.path(file.ID)); err == nil {
+		doc.Status = stored.Status
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	if update != nil {
+		if doc.Status == nil {
+			doc.Status = &fileStatus{}
+		}
+		if err := update(doc.Status); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.writeDiskWireFile(&doc); err != nil {
+		return nil, err
+	}
+	r.index.add(file)
+	return doc.Status, nil
+}
+
+func (r *diskWireFileRepository) writeDiskWireFile(doc *diskWireFile) error {
+	bs, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(r.path(doc.File.ID), bs)
+}
+
+func (r *diskWireFileRepository) deleteFile(fileId string) error {
+	if fileId == "" {
+		return errors.New("empty Wire File Id")
+	}
+	if !fileIDRegex.MatchString(fileId) {
+		return errInvalidFileID
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := os.Remove(r.path(fileId)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	r.index.remove(fileId)
+	return nil
+}
+
+func (r *diskWireFileRepository) getFileIdByIMAD(imad string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.index.fileID(imad), nil
+}
+
+func (r *diskWireFileRepository) getFileStatus(fileId string) (*fileStatus, error) {
+	if !fileIDRegex.MatchString(fileId) {
+		return nil, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	doc, err := readDiskWireFile(r.path(fileId))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc.Status, nil
+}
+
+func (r *diskWireFileRepository) updateFileStatus(fileId string, update func(*fileStatus) error) (*fileStatus, error) {
+	if !fileIDRegex.MatchString(fileId) {
+		return nil, errInvalidFileID
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	doc, err := readDiskWireFile(r.path(fileId))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("file=%s not found", fileId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if doc.Status == nil {
+		doc.Status = &fileStatus{}
+	}
+	if err := update(doc.Status); err != nil {
+		return nil, err
+	}
+	if err := r.writeDiskWireFile(doc); err != nil {
+		return nil, err
+	}
+	return doc.Status, nil
+}
+
+func readDiskWireFile(path string) (*diskWireFile, error) {
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc diskWireFile
+	if err := json.Unmarshal(bs, &doc); err != nil {
+		return nil, fmt.Errorf("problem reading %s: %v", filepath.Base(path), err)
+	}
+	if doc.File == nil {
+		return nil, fmt.Errorf("problem reading %s: no file", filepath.Base(path))
+	}
+	return &doc, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+func TestDiskStorage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wire-storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	repo, err := newDiskWireFileRepository(filepath.Join(dir, "files"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStorage(t, repo)
+}
+
+func TestDiskStorage__reopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wire-storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	repo, err := newDiskWireFileRepository(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.ID = base.ID()
+	if err := repo.saveFile(f); err != nil {
+		t.Fatal(err)
+	}
+
+	// the File, its FAIM text and its IMAD survive a restart
+	repo, err = newDiskWireFileRepository(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := repo.getFile(f.ID)
+	if err != nil || file == nil {
+		t.Fatalf("file=%#v error=%v", file, err)
+	}
+	if file.FEDWireMessage().InputMessageAccountabilityData.String() != f.FEDWireMessage().InputMessageAccountabilityData.String() {
+		t.Errorf("IMAD %v", file.FEDWireMessage().InputMessageAccountabilityData)
+	}
+	doc, err := readDiskWireFile(repo.path(f.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.FAIM == "" {
+		t.Error("no FAIM text stored")
+	}
+	dup := *f
+	dup.ID = base.ID()
+	if err := repo.saveFile(&dup); err == nil {
+		t.Error("expected duplicate error after restart")
+	}
+
+	// File IDs are filenames
+	f.ID = "../escape"
+	if err := repo.saveFile(f); err != errInvalidFileID {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if file, err := repo.getFile("../escape"); file != nil || err != nil {
+		t.Errorf("file=%#v error=%v", file, err)
+	}
+
+	// a corrupt File is reported
+	if err := ioutil.WriteFile(filepath.Join(dir, "corrupt.json"), []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newDiskWireFileRepository(dir); err == nil {
+		t.Error("expected error")
+	}
+
+	// Files read from JSON are stored
+	if err := os.Remove(filepath.Join(dir, "corrupt.json")); err != nil {
+		t.Fatal(err)
+	}
+	bs, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-BankTransfer.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON wire.File
+	if err := json.Unmarshal(bs, &fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	fromJSON.ID = base.ID()
+	fromJSON.FEDWireMessages[0].InputMessageAccountabilityData.InputSequenceNumber = "000099"
+	fromJSON.FEDWireMessages[0].SenderReference = nil
+	if err := repo.saveFile(&fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	if file, err := repo.getFile(fromJSON.ID); err != nil || file == nil {
+		t.Errorf("file=%#v error=%v", file, err)
+	}
+}
+
+// TestDiskStorage__reload validates a File read back after a restart is the File saved, including the fields
+// only JSON holds
+func TestDiskStorage__reload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wire-storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	repo, err := newDiskWireFileRepository(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.ID = base.ID()
+	f.FEDWireMessages[0].ID = base.ID()
+	f.FEDWireMessages[0].UnknownTags = []wire.UnknownTag{{Tag: "{3333}", Value: "Unknown", Position: 4}}
+	if err := repo.saveFile(f); err != nil {
+		t.Fatal(err)
+	}
+
+	repo, err = newDiskWireFileRepository(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := repo.getFile(f.ID)
+	if err != nil || file == nil {
+		t.Fatalf("file=%#v error=%v", file, err)
+	}
+	if !reflect.DeepEqual(file, f) {
+		t.Errorf("got\n%#v\nexpected\n%#v", file, f)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// duplicateMessageError is returned when a FEDWireMessage has the IMAD or SenderReference of a stored
+// FEDWireMessage. The Fedwire Funds Service rejects such a FEDWireMessage as a duplicate (X) unless it is a
+// resend, with MessageDuplicationCode P.
+type duplicateMessageError struct {
+	// Field is the duplicated field, IMAD or SenderReference
+	Field string `json:"field"`
+	Value string `json:"value"`
+	// FileID is the ID of the File which holds the stored FEDWireMessage
+	FileID string `json:"fileId"`
+}
+
+func (e *duplicateMessageError) Error() string {
+	return fmt.Sprintf("duplicate %s %s of file=%s, a resend requires MessageDuplicationCode %s",
+		e.Field, e.Value, e.FileID, wire.MessageDuplicationResend)
+}
+
+// duplicateMessageResponse is the response for a File which duplicates a stored FEDWireMessage
+type duplicateMessageResponse struct {
+	Error     string                 `json:"error"`
+	Duplicate *duplicateMessageError `json:"duplicate"`
+}
+
+// problemDuplicate responds with 409 Conflict and returns true when err is a duplicateMessageError
+func problemDuplicate(w http.ResponseWriter, err error) bool {
+	dup, ok := err.(*duplicateMessageError)
+	if !ok {
+		return false
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusConflict)
+	json.NewEncoder(w).Encode(duplicateMessageResponse{
+		Error:     dup.Error(),
+		Duplicate: dup,
+	})
+	return true
+}
+
+// messageIndex indexes the IMAD and SenderReference of stored FEDWireMessages by the ID of their File. It is
+// not safe for concurrent use, repositories hold their own lock while using it.
+type messageIndex struct {
+	imads            map[string]string
+	senderReferences map[string]string
+	// resends are the IMADs of resends, which are only indexed to find the File an acknowledgement is for
+	resends map[string]string
+}
+
+func newMessageIndex() *messageIndex {
+	return &messageIndex{
+		imads:            make(map[string]string),
+		senderReferences: make(map[string]string),
+		resends:          make(map[string]string),
+	}
+}
+
+// fileID returns the ID of the File holding the FEDWireMessage with imad, or an empty string when there is no
+// such File. A resend is the latest FEDWireMessage sent with its IMAD, so its File is returned before the File
+// of the FEDWireMessage it resends.
+func (idx *messageIndex) fileID(imad string) string {
+	if id, ok := idx.resends[imad]; ok {
+		return id
+	}
+	return idx.imads[imad]
+}
+
+// messageKeys returns the IMAD and the SenderReference, qualified by the sender's ABA number, of fwm. Either is
+// empty when fwm does not hold it.
+func messageKeys(fwm *wire.FEDWireMessage) (imad, senderReference string) {
+	if v := fwm.InputMessageAccountabilityData; v != nil && v.InputSequenceNumber != "" {
+		imad = strings.TrimSpace(v.InputCycleDate + v.InputSourceField() + v.InputSequenceNumber)
+	}
+	if fwm.SenderReference != nil && strings.TrimSpace(fwm.SenderReference.SenderReference) != "" {
+		senderReference = strings.TrimSpace(fwm.SenderReference.SenderReference)
+		if fwm.SenderDepositoryInstitution != nil {
+			senderReference = fwm.SenderDepositoryInstitution.SenderABANumber + "/" + senderReference
+		}
+	}
+	return imad, senderReference
+}
+
+// isResend returns true when fwm is a resend of a FEDWireMessage which was sent before
+func isResend(fwm *wire.FEDWireMessage) bool {
+	return fwm.SenderSupplied != nil && fwm.SenderSupplied.MessageDuplicationCode == wire.MessageDuplicationResend
+}
+
+// check returns a duplicateMessageError for the first FEDWireMessage of file, other than a resend, with the
+// IMAD or SenderReference of a FEDWireMessage stored in another File or earlier in file.
+func (idx *messageIndex) check(file *wire.File) error {
+	imads := make(map[string]bool)
+	senderReferences := make(map[string]bool)
+	for i := range file.FEDWireMessages {
+		if isResend(&file.FEDWireMessages[i]) {
+			continue
+		}
+		imad, senderReference := messageKeys(&file.FEDWireMessages[i])
+		if err := duplicate("IMAD", imad, file.ID, idx.imads, imads); err != nil {
+			return err
+		}
+		if err := duplicate("SenderReference", senderReference, file.ID, idx.senderReferences, senderReferences); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// duplicate returns a duplicateMessageError when key is indexed for a File other than fileID or was seen
+// earlier in the File, and marks key as seen
+func duplicate(field, key, fileID string, indexed map[string]string, seen map[string]bool) error {
+	if key == "" {
+		return nil
+	}
+	if id, ok := indexed[key]; ok && id != fileID {
+		return &duplicateMessageError{Field: field, Value: key, FileID: id}
+	}
+	if seen[key] {
+		return &duplicateMessageError{Field: field, Value: key, FileID: fileID}
+	}
+	seen[key] = true
+	return nil
+}
+
+// add indexes the FEDWireMessages of file, replacing the FEDWireMessages indexed for a previous version of
+// file. Only the IMAD of a resend is indexed, for fileID, so a resend is never found by check.
+func (idx *messageIndex) add(file *wire.File) {
+	idx.remove(file.ID)
+	for i := range file.FEDWireMessages {
+		imad, senderReference := messageKeys(&file.FEDWireMessages[i])
+		if isResend(&file.FEDWireMessages[i]) {
+			if imad != "" {
+				idx.resends[imad] = file.ID
+			}
+			continue
+		}
+		if imad != "" {
+			idx.imads[imad] = file.ID
+		}
+		if senderReference != "" {
+			idx.senderReferences[senderReference] = file.ID
+		}
+	}
+}
+
+// remove removes the FEDWireMessages of the File fileID from the index
+func (idx *messageIndex) remove(fileID string) {
+	for k, id := range idx.imads {
+		if id == fileID {
+			delete(idx.imads, k)
+		}
+	}
+	for k, id := range idx.senderReferences {
+		if id == fileID {
+			delete(idx.senderReferences, k)
+		}
+	}
+	for k, id := range idx.resends {
+		if id == fileID {
+			delete(idx.resends, k)
+		}
+	}
+}
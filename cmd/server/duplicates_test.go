@@ -0,0 +1,126 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/moov-io/wire"
+
+	"github.com/go-kit/kit/log"
+	"github.com/gorilla/mux"
+)
+
+func TestMessageIndex(t *testing.T) {
+	idx := newMessageIndex()
+
+	first := &wire.File{ID: "first", FEDWireMessages: []wire.FEDWireMessage{mockFEDWireMessage()}}
+	if err := idx.check(first); err != nil {
+		t.Fatal(err)
+	}
+	idx.add(first)
+	// saving the same File again is not a duplicate
+	if err := idx.check(first); err != nil {
+		t.Fatal(err)
+	}
+
+	// same IMAD
+	fwm := mockFEDWireMessage()
+	fwm.SenderReference = nil
+	second := &wire.File{ID: "second", FEDWireMessages: []wire.FEDWireMessage{fwm}}
+	err := idx.check(second)
+	if dup, ok := err.(*duplicateMessageError); !ok || dup.Field != "IMAD" || dup.FileID != "first" {
+		t.Errorf("unexpected error: %#v", err)
+	}
+
+	// same SenderReference
+	fwm = mockFEDWireMessage()
+	fwm.InputMessageAccountabilityData.InputSequenceNumber = "000002"
+	second.FEDWireMessages = []wire.FEDWireMessage{fwm}
+	err = idx.check(second)
+	if dup, ok := err.(*duplicateMessageError); !ok || dup.Field != "SenderReference" {
+		t.Errorf("unexpected error: %#v", err)
+	}
+
+	// a resend may repeat both
+	fwm.SenderSupplied.MessageDuplicationCode = wire.MessageDuplicationResend
+	if err := idx.check(second); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	// and its IMAD finds its File, though it is still not a duplicate of a later resend
+	fwm = mockFEDWireMessage()
+	fwm.SenderSupplied.MessageDuplicationCode = wire.MessageDuplicationResend
+	second.FEDWireMessages = []wire.FEDWireMessage{fwm}
+	idx.add(second)
+	imad, _ := messageKeys(&fwm)
+	if id := idx.fileID(imad); id != "second" {
+		t.Errorf("IMAD of the resend is in file=%s", id)
+	}
+	if err := idx.check(&wire.File{ID: "fourth", FEDWireMessages: []wire.FEDWireMessage{fwm}}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	idx.remove("second")
+	if id := idx.fileID(imad); id != "first" {
+		t.Errorf("IMAD is in file=%s", id)
+	}
+
+	// duplicates within a File
+	third := &wire.File{ID: "third", FEDWireMessages: []wire.FEDWireMessage{mockFEDWireMessage(), mockFEDWireMessage()}}
+	idx.remove("first")
+	err = idx.check(third)
+	if dup, ok := err.(*duplicateMessageError); !ok || dup.FileID != "third" {
+		t.Errorf("unexpected error: %#v", err)
+	}
+}
+
+func TestFiles__createFileDuplicate(t *testing.T) {
+	repo := &memoryWireFileRepository{
+		files: make(map[string]*wire.File),
+	}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	create := func(fwm wire.FEDWireMessage) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		if err := json.NewEncoder(&body).Encode(wire.File{FEDWireMessages: []wire.FEDWireMessage{fwm}}); err != nil {
+			t.Fatal(err)
+		}
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/files/create", &body)
+		req.Header.Set("content-type", "application/json")
+		router.ServeHTTP(w, req)
+		w.Flush()
+		return w
+	}
+
+	if w := create(mockFEDWireMessage()); w.Code != http.StatusCreated {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	w := create(mockFEDWireMessage())
+	if w.Code != http.StatusConflict {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	var resp duplicateMessageResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Duplicate == nil || resp.Duplicate.Field != "IMAD" {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+
+	resend := mockFEDWireMessage()
+	resend.SenderSupplied.MessageDuplicationCode = wire.MessageDuplicationResend
+	if w := create(resend); w.Code != http.StatusCreated {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if files, _ := repo.getFiles(); len(files) != 2 {
+		t.Errorf("unexpected %d files", len(files))
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/moov-io/base"
 	"github.com/moov-io/wire"
@@ -37,14 +38,20 @@ var (
 	errNoFEDWireMessageID = errors.New("no FEDWireMessage ID found")
 )
 
-func addFileRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository) {
+// addFileRoutes adds the routes of Files, checking their routing numbers against directory when it is not nil
+func addFileRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository, imads *imadAssigner, directory wire.RoutingDirectory) {
 	r.Methods("GET").Path("/files").HandlerFunc(getFiles(logger, repo))
-	r.Methods("POST").Path("/files/create").HandlerFunc(createFile(logger, repo))
+	r.Methods("POST").Path("/files/create").HandlerFunc(createFile(logger, repo, imads, directory))
 	r.Methods("GET").Path("/files/{fileId}").HandlerFunc(getFile(logger, repo))
 	r.Methods("DELETE").Path("/files/{fileId}").HandlerFunc(deleteFile(logger, repo))
 	r.Methods("GET").Path("/files/{fileId}/contents").HandlerFunc(getFileContents(logger, repo))
-	r.Methods("GET").Path("/files/{fileId}/validate").HandlerFunc(validateFile(logger, repo))
-	r.Methods("POST").Path("/files/{fileId}/FEDWireMessage").HandlerFunc(addFEDWireMessageToFile(logger, repo))
+	r.Methods("GET").Path("/files/{fileId}/validate").HandlerFunc(validateFile(logger, repo, directory))
+	r.Methods("GET").Path("/files/{fileId}/diff/{otherFileId}").HandlerFunc(diffFiles(logger, repo))
+	r.Methods("POST").Path("/files/{fileId}/FEDWireMessage").HandlerFunc(addFEDWireMessageToFile(logger, repo, imads, directory))
+	r.Methods("POST").Path("/files/{fileId}/approve").HandlerFunc(transitionFile(logger, repo, directory, statusApproved))
+	r.Methods("POST").Path("/files/{fileId}/send").HandlerFunc(transitionFile(logger, repo, directory, statusSent))
+	r.Methods("POST").Path("/files/{fileId}/ack").HandlerFunc(transitionFile(logger, repo, directory, statusAcknowledged))
+	r.Methods("POST").Path("/files/{fileId}/reject").HandlerFunc(transitionFile(logger, repo, directory, statusRejected))
 }
 
 func getFileId(w http.ResponseWriter, r *http.Request) string {
@@ -79,14 +86,24 @@ func getFiles(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			logger.Log("files", fmt.Sprintf("found %d files", len(files)), "requestId", requestId)
 		}
 
+		resp := make([]fileResponse, len(files))
+		for i := range files {
+			status, err := repo.getFileStatus(files[i].ID)
+			if err != nil {
+				moovhttp.Problem(w, err)
+				return
+			}
+			resp[i] = fileResponse{File: files[i], Status: status}
+		}
+
 		w.Header().Set("X-Total-Count", fmt.Sprintf("%d", len(files)))
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(http.StatusOK)
-		json.NewEncoder(w).Encode(files)
+		json.NewEncoder(w).Encode(resp)
 	}
 }
 
-func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func createFile(logger log.Logger, repo WireFileRepository, imads *imadAssigner, directory wire.RoutingDirectory) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w = wrapResponseWriter(logger, w, r)
 
@@ -101,7 +118,9 @@ func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		} else {
 			file, err := wire.NewReader(r.Body).Read()
 			if err != nil {
-				moovhttp.Problem(w, err)
+				if !problemParse(w, err) {
+					moovhttp.Problem(w, err)
+				}
 				return
 			}
 			req = &file
@@ -109,13 +128,29 @@ func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		if req.ID == "" {
 			req.ID = base.ID()
 		}
+		req.SetRoutingDirectory(directory)
 
 		requestID := moovhttp.GetRequestID(r)
-		if err := repo.saveFile(req); err != nil {
-			logger.Log("files", fmt.Sprintf("problem saving file %s: %v", req.ID, err), "requestId", requestID)
+		if err := imads.assign(req); err != nil {
+			logger.Log("files", fmt.Sprintf("problem assigning IMAD of file %s: %v", req.ID, err), "requestId", requestID)
 			moovhttp.Problem(w, err)
 			return
 		}
+		// the File is saved along with its status, which is checked under the lock of repo
+		to, actor := validationStatus(req), moovhttp.GetUserID(r)
+		status, err := repo.saveFileWithStatus(req, func(status *fileStatus) error {
+			if !status.editable() {
+				return errFileNotEditable
+			}
+			return status.transition(to, actor, time.Now())
+		})
+		if err != nil {
+			logger.Log("files", fmt.Sprintf("problem saving file %s: %v", req.ID, err), "requestId", requestID)
+			if !problemConflict(w, err) && !problemDuplicate(w, err) {
+				moovhttp.Problem(w, err)
+			}
+			return
+		}
 		logger.Log("files", fmt.Sprintf("creatd file=%s", req.ID), "requestId", requestID)
 
 		// record a metric for files created
@@ -123,7 +158,7 @@ func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(http.StatusCreated)
-		json.NewEncoder(w).Encode(req)
+		json.NewEncoder(w).Encode(fileResponse{File: req, Status: status})
 	}
 }
 
@@ -144,9 +179,16 @@ func getFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		if requestId := moovhttp.GetRequestID(r); requestId != "" {
 			logger.Log("files", fmt.Sprintf("rendering file=%s", fileId), "requestId", requestId)
 		}
+		resp := fileResponse{File: file}
+		if file != nil {
+			if resp.Status, err = repo.getFileStatus(fileId); err != nil {
+				moovhttp.Problem(w, err)
+				return
+			}
+		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(http.StatusOK)
-		json.NewEncoder(w).Encode(file)
+		json.NewEncoder(w).Encode(resp)
 	}
 }
 
@@ -158,6 +200,15 @@ func deleteFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		if fileId == "" {
 			return
 		}
+		status, err := repo.getFileStatus(fileId)
+		if err != nil {
+			moovhttp.Problem(w, err)
+			return
+		}
+		if !status.deletable() {
+			problemConflict(w, &transitionError{From: status.Status, To: "deleted"})
+			return
+		}
 		if err := repo.deleteFile(fileId); err != nil {
 			moovhttp.Problem(w, err)
 			return
@@ -200,7 +251,56 @@ func getFileContents(logger log.Logger, repo WireFileRepository) http.HandlerFun
 	}
 }
 
-func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+// diffFiles responds with the differences between the FEDWireMessages of two Files, as JSON or as text when
+// text/plain is accepted
+func diffFiles(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w = wrapResponseWriter(logger, w, r)
+
+		fileId := getFileId(w, r)
+		if fileId == "" {
+			return
+		}
+		otherFileId := mux.Vars(r)["otherFileId"]
+		files := make([]*wire.File, 2)
+		for i, id := range []string{fileId, otherFileId} {
+			file, err := repo.getFile(id)
+			if err != nil {
+				moovhttp.Problem(w, err)
+				return
+			}
+			if file == nil {
+				moovhttp.Problem(w, fmt.Errorf("file=%s not found", id))
+				return
+			}
+			files[i] = file
+		}
+		diffs := wire.DiffFiles(files[0], files[1])
+		if requestId := moovhttp.GetRequestID(r); requestId != "" {
+			logger.Log("files", fmt.Sprintf("file=%s has %d differences from file=%s", otherFileId, len(diffs), fileId), "requestId", requestId)
+		}
+		if strings.Contains(r.Header.Get("Accept"), "text/plain") {
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(diffs.String()))
+			return
+		}
+		if diffs == nil {
+			diffs = wire.Differences{}
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(diffs)
+	}
+}
+
+// validationErrorsResponse is the response for a File which failed validation
+type validationErrorsResponse struct {
+	Error  string                `json:"error"`
+	Errors wire.ValidationErrors `json:"errors"`
+}
+
+func validateFile(logger log.Logger, repo WireFileRepository, directory wire.RoutingDirectory) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w = wrapResponseWriter(logger, w, r)
 
@@ -213,11 +313,18 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			moovhttp.Problem(w, err)
 			return
 		}
-		if err := file.Create(); err != nil { // Create calls Validate
+		file.SetRoutingDirectory(directory)
+		if errs := file.ValidateAll(); len(errs) > 0 {
 			if requestId := moovhttp.GetRequestID(r); requestId != "" {
-				logger.Log("files", fmt.Sprintf("file=%s was invalid: %v", fileId, err), "requestId", requestId)
+				logger.Log("files", fmt.Sprintf("file=%s was invalid: %v", fileId, errs), "requestId", requestId)
 			}
-			moovhttp.Problem(w, err)
+			// report every problem found rather than only the first
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(validationErrorsResponse{
+				Error:  errs.Error(),
+				Errors: errs,
+			})
 			return
 		}
 		if requestId := moovhttp.GetRequestID(r); requestId != "" {
@@ -229,7 +336,7 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	}
 }
 
-func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository, imads *imadAssigner, directory wire.RoutingDirectory) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w = wrapResponseWriter(logger, w, r)
 
@@ -248,16 +355,42 @@ func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.Ha
 			moovhttp.Problem(w, err)
 			return
 		}
-		file.FEDWireMessage = file.AddFEDWireMessage(req)
-		if err := repo.saveFile(file); err != nil {
+		// an IMAD is not assigned to a FEDWireMessage which can not be added, though the status is checked again
+		// when the File is saved
+		status, err := repo.getFileStatus(fileId)
+		if err != nil {
+			moovhttp.Problem(w, err)
+			return
+		}
+		if !status.editable() {
+			problemConflict(w, errFileNotEditable)
+			return
+		}
+		add := wire.File{FEDWireMessages: []wire.FEDWireMessage{req}}
+		if err := imads.assign(&add); err != nil {
 			moovhttp.Problem(w, err)
 			return
 		}
+		file.AddFEDWireMessage(add.FEDWireMessages[0])
+		file.SetRoutingDirectory(directory)
+		to, actor := validationStatus(file), moovhttp.GetUserID(r)
+		status, err = repo.saveFileWithStatus(file, func(status *fileStatus) error {
+			if !status.editable() {
+				return errFileNotEditable
+			}
+			return status.transition(to, actor, time.Now())
+		})
+		if err != nil {
+			if !problemConflict(w, err) && !problemDuplicate(w, err) {
+				moovhttp.Problem(w, err)
+			}
+			return
+		}
 		if requestId := moovhttp.GetRequestID(r); requestId != "" {
 			logger.Log("files", fmt.Sprintf("added FEDWireMessage=%s to file=%s", req.ID, fileId), "requestId", requestId)
 		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(http.StatusOK)
-		json.NewEncoder(w).Encode(file)
+		json.NewEncoder(w).Encode(fileResponse{File: file, Status: status})
 	}
 }
@@ -59,7 +59,7 @@ func TestFiles__getFiles(t *testing.T) {
 	}
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
@@ -107,7 +107,7 @@ func TestFiles__createFile(t *testing.T) {
 	repo := &testWireFileRepository{}
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
@@ -121,7 +121,7 @@ func TestFiles__createFile(t *testing.T) {
 	if resp.ID == "" {
 		t.Errorf("empty response File: %#v", resp)
 	}
-	if resp.FEDWireMessage.FIAdditionalFIToFI == nil {
+	if resp.FEDWireMessage().FIAdditionalFIToFI == nil {
 		t.Error("FIAdditionalFIToFI shouldn't be nil")
 	}
 
@@ -137,6 +137,40 @@ func TestFiles__createFile(t *testing.T) {
 	}
 }
 
+func TestFiles__createFileParseError(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs = bytes.Replace(bs, []byte("{2000}000001234567"), []byte("{2000}00000123456Z"), 1)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
+
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, &testWireFileRepository{}, nil, nil)
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	var resp parseErrorResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.ParseErrors) != 1 {
+		t.Fatalf("unexpected response: %#v", resp)
+	}
+	pe := resp.ParseErrors[0]
+	if pe.Line != 4 || pe.Record != "Amount" || pe.Field != "Amount" || pe.Start != 6 || pe.End != 18 {
+		t.Errorf("unexpected parseError: %#v", pe)
+	}
+	if !strings.HasSuffix(pe.Snippet, "\n      ^^^^^^^^^^^^") {
+		t.Errorf("unexpected snippet:\n%s", pe.Snippet)
+	}
+}
+
 func TestFiles__createFileJSON(t *testing.T) {
 	bs, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-BankTransfer.json"))
 	if err != nil {
@@ -150,7 +184,7 @@ func TestFiles__createFileJSON(t *testing.T) {
 	repo := &testWireFileRepository{}
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
@@ -165,7 +199,7 @@ func TestFiles__createFileJSON(t *testing.T) {
 	if resp.ID == "" {
 		t.Errorf("empty response File: %#v", resp)
 	}
-	if resp.FEDWireMessage.FIAdditionalFIToFI == nil {
+	if resp.FEDWireMessage().FIAdditionalFIToFI == nil {
 		t.Error("FIAdditionalFIToFI shouldn't be nil")
 	}
 
@@ -193,7 +227,7 @@ func TestFiles__getFile(t *testing.T) {
 	}
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
@@ -227,7 +261,7 @@ func TestFiles__deleteFile(t *testing.T) {
 	repo := &testWireFileRepository{}
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
@@ -255,13 +289,13 @@ func TestFiles__getFileContents(t *testing.T) {
 
 	repo := &testWireFileRepository{
 		file: &wire.File{
-			ID:             base.ID(),
-			FEDWireMessage: fwm,
+			ID:              base.ID(),
+			FEDWireMessages: []wire.FEDWireMessage{fwm},
 		},
 	}
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
@@ -295,7 +329,7 @@ func TestFiles__validateFile(t *testing.T) {
 	repo := &testWireFileRepository{file: f}
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
@@ -306,6 +340,26 @@ func TestFiles__validateFile(t *testing.T) {
 		t.Errorf("unexpected body: %v", w.Body.String())
 	}
 
+	// invalid file
+	fwm := f.FEDWireMessage()
+	fwm.Amount.Amount = "00000000000Z"
+	fwm.SenderDepositoryInstitution.SenderABANumber = "12104288Z"
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %v", w.Code, w.Body.String())
+	}
+	var resp validationErrorsResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Errors) != 2 || resp.Errors[0].Tag != wire.TagAmount || resp.Errors[1].Tag != wire.TagSenderDepositoryInstitution {
+		t.Errorf("unexpected errors: %#v", resp.Errors)
+	}
+
 	// error case
 	repo.err = errors.New("bad error")
 
@@ -318,6 +372,91 @@ func TestFiles__validateFile(t *testing.T) {
 	}
 }
 
+// participants is a wire.RoutingDirectory of the routing numbers set to true
+type participants map[string]bool
+
+func (p participants) IsParticipant(routingNumber string) bool {
+	return p[routingNumber]
+}
+
+// TestFiles__validateFileDirectory validates routing numbers are checked against the directory of the routes
+func TestFiles__validateFileDirectory(t *testing.T) {
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &testWireFileRepository{file: f}
+
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, participants{"121042882": true})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/foo/validate", nil))
+	w.Flush()
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("bogus HTTP status: %d: %v", w.Code, w.Body.String())
+	}
+	var resp validationErrorsResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Errors) != 1 || resp.Errors[0].Tag != wire.TagReceiverDepositoryInstitution || resp.Errors[0].Value != "231380104" {
+		t.Errorf("unexpected errors: %#v", resp.Errors)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/files/foo/approve", nil))
+	w.Flush()
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %v", w.Code, w.Body.String())
+	}
+}
+
+func TestFiles__diffFiles(t *testing.T) {
+	first, second := mockFEDWireMessage(), mockFEDWireMessage()
+	amt := wire.NewAmount()
+	amt.Amount = "000000000100"
+	second.SetAmount(amt)
+	repo := &memoryWireFileRepository{
+		files: map[string]*wire.File{
+			"first":  {ID: "first", FEDWireMessages: []wire.FEDWireMessage{first}},
+			"second": {ID: "second", FEDWireMessages: []wire.FEDWireMessage{second}},
+		},
+	}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/first/diff/second", nil))
+	w.Flush()
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	var diffs wire.Differences
+	if err := json.NewDecoder(w.Body).Decode(&diffs); err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 1 || diffs[0].Tag != wire.TagAmount || diffs[0].To != "000000000100" {
+		t.Errorf("unexpected differences: %#v", diffs)
+	}
+
+	w = httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/files/first/diff/second", nil)
+	req.Header.Set("Accept", "text/plain")
+	router.ServeHTTP(w, req)
+	w.Flush()
+	if w.Code != http.StatusOK || !strings.HasPrefix(w.Body.String(), "~ {2000} amount.amount") {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/first/diff/missing", nil))
+	w.Flush()
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+}
+
 func TestFiles__addFEDWireMessageToFile(t *testing.T) {
 	f, err := readFile("fedWireMessage-NoMessage.txt")
 	if err != nil {
@@ -336,7 +475,7 @@ func TestFiles__addFEDWireMessageToFile(t *testing.T) {
 	req := httptest.NewRequest("POST", "/files/foo/FEDWireMessage", &buf)
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
@@ -347,8 +486,25 @@ func TestFiles__addFEDWireMessageToFile(t *testing.T) {
 	if err := json.NewDecoder(w.Body).Decode(&out); err != nil {
 		t.Fatal(err)
 	}
-	if out.FEDWireMessage.SenderSupplied == nil {
-		t.Errorf("FEDWireMessage: %#v", out.FEDWireMessage)
+	if out.FEDWireMessage().SenderSupplied == nil {
+		t.Errorf("FEDWireMessage: %#v", out.FEDWireMessage())
+	}
+
+	// a second FEDWireMessage is appended
+	if err := json.NewEncoder(&buf).Encode(fwm); err != nil {
+		t.Fatal(err)
+	}
+	repo.file = &out
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status: %d: %v", w.Code, w.Body.String())
+	}
+	if n := len(repo.file.FEDWireMessages); n != 2 {
+		t.Errorf("got %d FEDWireMessages", n)
 	}
 
 	// error case
@@ -374,13 +530,13 @@ func TestFiles__addFEDWireMessageToFile(t *testing.T) {
 	repo := &testWireFileRepository{file: f}
 
 	FEDWireMessageID := base.ID()
-	repo.file.FEDWireMessage.ID = FedWireMessageID
+	repo.file.FEDWireMessage().ID = FedWireMessageID
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest("DELETE", fmt.Sprintf("/files/foo/FEDWireMessage/%s", FEDWireMessageID), nil)
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
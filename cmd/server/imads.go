@@ -0,0 +1,69 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/moov-io/wire"
+)
+
+var errNoInputSource = errors.New("no IMAD InputSource found")
+
+// imadAssigner assigns the InputMessageAccountabilityData (IMAD) of FEDWireMessages created without an
+// InputSequenceNumber. The IMAD is allocated on the cycle date open at the time the FEDWireMessage is created.
+type imadAssigner struct {
+	allocator *wire.IMADAllocator
+	// inputSource is the InputSource of FEDWireMessages created without one
+	inputSource string
+	schedule    *wire.Schedule
+	now         func() time.Time
+}
+
+func newIMADAssigner(allocator *wire.IMADAllocator, inputSource string) *imadAssigner {
+	return &imadAssigner{
+		allocator:   allocator,
+		inputSource: inputSource,
+		schedule:    wire.NewSchedule(),
+		now:         time.Now,
+	}
+}
+
+// assign allocates the IMAD of each FEDWireMessage in file without an InputSequenceNumber. FEDWireMessages with
+// an InputSequenceNumber keep their IMAD. A nil imadAssigner assigns nothing.
+func (a *imadAssigner) assign(file *wire.File) error {
+	if a == nil {
+		return nil
+	}
+	for i := range file.FEDWireMessages {
+		if err := a.assignMessage(&file.FEDWireMessages[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *imadAssigner) assignMessage(fwm *wire.FEDWireMessage) error {
+	source := a.inputSource
+	if current := fwm.InputMessageAccountabilityData; current != nil {
+		if current.InputSequenceNumber != "" {
+			return nil
+		}
+		if current.InputSource != "" {
+			source = current.InputSource
+		}
+	}
+	if source == "" {
+		return errNoInputSource
+	}
+	cycleDate := a.schedule.CycleDate(a.now()).Format("20060102")
+	imad, err := a.allocator.Allocate(source, cycleDate)
+	if err != nil {
+		return err
+	}
+	fwm.SetInputMessageAccountabilityData(imad)
+	return nil
+}
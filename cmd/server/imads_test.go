@@ -0,0 +1,101 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/moov-io/wire"
+
+	"github.com/go-kit/kit/log"
+	"github.com/gorilla/mux"
+)
+
+func mockIMADAssigner(t *testing.T) *imadAssigner {
+	allocator, err := wire.NewIMADAllocator("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	imads := newIMADAssigner(allocator, "Source09")
+	imads.now = func() time.Time {
+		// Wednesday April 10th 2019 at noon in New York
+		return time.Date(2019, time.April, 10, 16, 0, 0, 0, time.UTC)
+	}
+	return imads
+}
+
+func TestIMADAssigner(t *testing.T) {
+	imads := mockIMADAssigner(t)
+
+	withIMAD := mockFEDWireMessage()
+	withoutSequence := mockFEDWireMessage()
+	withoutSequence.InputMessageAccountabilityData.InputSequenceNumber = ""
+	withoutIMAD := mockFEDWireMessage()
+	withoutIMAD.InputMessageAccountabilityData = nil
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(withIMAD)
+	file.AddFEDWireMessage(withoutSequence)
+	file.AddFEDWireMessage(withoutIMAD)
+	if err := imads.assign(file); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		withIMAD.InputMessageAccountabilityData.String(),
+		"{1520}20190410Source08000001",
+		"{1520}20190410Source09000001",
+	}
+	for i := range file.FEDWireMessages {
+		if v := file.FEDWireMessages[i].InputMessageAccountabilityData.String(); v != want[i] {
+			t.Errorf("FEDWireMessage %d: IMAD %s, want %s", i, v, want[i])
+		}
+	}
+
+	// without an InputSource nothing is allocated
+	imads.inputSource = ""
+	if err := imads.assign(&wire.File{FEDWireMessages: []wire.FEDWireMessage{withoutIMAD}}); err != errNoInputSource {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	var nilAssigner *imadAssigner
+	if err := nilAssigner.assign(file); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFiles__addFEDWireMessageAssignsIMAD(t *testing.T) {
+	fwm := mockFEDWireMessage()
+	fwm.InputMessageAccountabilityData = nil
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(fwm); err != nil {
+		t.Fatal(err)
+	}
+
+	repo := &testWireFileRepository{file: &wire.File{ID: "foo"}}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, mockIMADAssigner(t), nil)
+
+	for _, sequence := range []string{"000001", "000002"} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/files/foo/FEDWireMessage", bytes.NewReader(body.Bytes()))
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+		}
+		n := len(repo.file.FEDWireMessages)
+		imad := repo.file.FEDWireMessages[n-1].InputMessageAccountabilityData
+		if imad == nil || imad.InputSequenceNumber != sequence {
+			t.Errorf("IMAD %v, want InputSequenceNumber %s", imad, sequence)
+		}
+	}
+}
@@ -30,6 +30,8 @@ var (
 	adminAddr = flag.String("admin.addr", bind.Admin("wire"), "Admin HTTP listen address")
 
 	flagLogFormat = flag.String("log.format", "", "Format for log lines (Options: json, plain")
+
+	flagStorageDir = flag.String("storage.dir", "", "Directory to store Wire files in, files are only held in memory when empty")
 )
 
 func main() {
@@ -68,15 +70,47 @@ func main() {
 	}()
 	defer adminServer.Shutdown()
 
-	repo := &memoryWireFileRepository{
+	var directory wire.RoutingDirectory
+	if path := os.Getenv("FEDWIRE_DIRECTORY_FILE"); path != "" {
+		fpddir, err := readFedwireDirectory(path)
+		if err != nil {
+			logger.Log("startup", fmt.Sprintf("problem reading Fedwire participant directory: %v", err))
+			os.Exit(1)
+		}
+		directory = fpddir
+		logger.Log("startup", fmt.Sprintf("checking routing numbers against %s", path))
+	}
+
+	var imads *imadAssigner
+	if source := os.Getenv("IMAD_INPUT_SOURCE"); source != "" {
+		allocator, err := wire.NewIMADAllocator(os.Getenv("IMAD_STATE_FILE"))
+		if err != nil {
+			logger.Log("startup", fmt.Sprintf("problem reading IMAD allocator state: %v", err))
+			os.Exit(1)
+		}
+		imads = newIMADAssigner(allocator, source)
+		logger.Log("startup", fmt.Sprintf("assigning IMADs of input source %s", source))
+	}
+
+	var repo WireFileRepository = &memoryWireFileRepository{
 		files: make(map[string]*wire.File),
 	}
+	if *flagStorageDir != "" {
+		disk, err := newDiskWireFileRepository(*flagStorageDir)
+		if err != nil {
+			logger.Log("startup", fmt.Sprintf("problem opening storage directory: %v", err))
+			os.Exit(1)
+		}
+		repo = disk
+		logger.Log("startup", fmt.Sprintf("storing files in %s", *flagStorageDir))
+	}
 
 	// Setup business HTTP routes
 	router := mux.NewRouter()
 	moovhttp.AddCORSHandler(router)
 	addPingRoute(router)
-	addFileRoutes(logger, router, repo)
+	addFileRoutes(logger, router, repo, imads, directory)
+	addAcknowledgementRoutes(logger, router, repo)
 
 	// Start business HTTP server
 	readTimeout, _ := time.ParseDuration("30s")
@@ -123,6 +157,16 @@ func main() {
 	}
 }
 
+// readFedwireDirectory reads the Fedwire participant directory at path
+func readFedwireDirectory(path string) (*wire.FedwireDirectory, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	return wire.ReadFedwireDirectory(fd)
+}
+
 func addPingRoute(r *mux.Router) {
 	r.Methods("GET").Path("/ping").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		moovhttp.SetAccessControlAllowHeaders(w, r.Header.Get("Origin"))
@@ -0,0 +1,77 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+// parseError describes a problem reading a line of a File, along with the characters of the line with the
+// problem when they are known
+type parseError struct {
+	Line   int    `json:"line"`
+	Record string `json:"record,omitempty"`
+	Field  string `json:"field,omitempty"`
+	// Start and End are the offsets of the first character with the problem and the character after the last
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Text  string `json:"text,omitempty"`
+	Error string `json:"error"`
+	// Snippet is Text followed by a line marking the characters with the problem with carets
+	Snippet string `json:"snippet,omitempty"`
+}
+
+// parseErrors returns a parseError for each base.ParseError of err
+func parseErrors(err error) []parseError {
+	el, ok := err.(base.ErrorList)
+	if !ok {
+		el = base.ErrorList{err}
+	}
+	var out []parseError
+	for i := range el {
+		pe, ok := el[i].(*base.ParseError)
+		if !ok {
+			continue
+		}
+		e := parseError{
+			Line:   pe.Line,
+			Record: pe.Record,
+			Error:  pe.Err.Error(),
+		}
+		if ce, ok := pe.Err.(*wire.ColumnError); ok {
+			e.Field = ce.Field
+			e.Start, e.End = ce.Start, ce.End
+			e.Text = ce.Text
+			e.Snippet = ce.Snippet()
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+// parseErrorResponse is the response for a File which could not be read
+type parseErrorResponse struct {
+	Error       string       `json:"error"`
+	ParseErrors []parseError `json:"parseErrors"`
+}
+
+// problemParse responds with 400 Bad Request and returns true when err holds problems reading the lines of a File
+func problemParse(w http.ResponseWriter, err error) bool {
+	errs := parseErrors(err)
+	if len(errs) == 0 {
+		return false
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(parseErrorResponse{
+		Error:       err.Error(),
+		ParseErrors: errs,
+	})
+	return true
+}
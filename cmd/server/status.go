@@ -0,0 +1,183 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/wire"
+
+	"github.com/go-kit/kit/log"
+)
+
+// The statuses of a File through its lifecycle. A File is a draft until it validates, is approved before it is
+// sent and once sent is either acknowledged or rejected by the Fedwire Funds Service.
+const (
+	statusDraft        = "draft"
+	statusValidated    = "validated"
+	statusApproved     = "approved"
+	statusSent         = "sent"
+	statusAcknowledged = "acknowledged"
+	statusRejected     = "rejected"
+)
+
+// statusTransitions are the statuses a File may move to from each status
+var statusTransitions = map[string][]string{
+	statusDraft:     {statusDraft, statusValidated},
+	statusValidated: {statusDraft, statusValidated, statusApproved},
+	statusApproved:  {statusSent},
+	statusSent:      {statusAcknowledged, statusRejected},
+}
+
+var errFileNotEditable = errors.New("File can not be changed once approved")
+
+// transitionError is returned when a File can not move from its status to another
+type transitionError struct {
+	From, To string
+}
+
+func (e *transitionError) Error() string {
+	return fmt.Sprintf("File can not move from %s to %s", e.From, e.To)
+}
+
+// fileStatus is the status of a File and every transition which led to it
+type fileStatus struct {
+	Status      string           `json:"status"`
+	Transitions []fileTransition `json:"transitions"`
+	// Acknowledgements are the acknowledgements of the FEDWireMessages of a sent File
+	Acknowledgements []messageAcknowledgement `json:"acknowledgements,omitempty"`
+}
+
+// fileTransition records a File moving to Status
+type fileTransition struct {
+	Status string `json:"status"`
+	// Actor is the X-User-ID of the request which moved the File
+	Actor     string    `json:"actor,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	// Reason is why the File was rejected
+	Reason string `json:"reason,omitempty"`
+}
+
+// transition moves s to status, recording actor and when. A transition to the current draft or validated
+// status is not recorded.
+func (s *fileStatus) transition(status, actor string, when time.Time) error {
+	if s.Status != "" {
+		allowed := false
+		for _, to := range statusTransitions[s.Status] {
+			allowed = allowed || to == status
+		}
+		if !allowed {
+			return &transitionError{From: s.Status, To: status}
+		}
+		if s.Status == status {
+			return nil
+		}
+	}
+	s.Status = status
+	s.Transitions = append(s.Transitions, fileTransition{
+		Status:    status,
+		Actor:     actor,
+		Timestamp: when.UTC(),
+	})
+	return nil
+}
+
+// editable returns true when the File may be changed
+func (s *fileStatus) editable() bool {
+	return s == nil || s.Status == "" || s.Status == statusDraft || s.Status == statusValidated
+}
+
+// deletable returns true when the File is not approved or in flight to the Fedwire Funds Service
+func (s *fileStatus) deletable() bool {
+	return s == nil || (s.Status != statusApproved && s.Status != statusSent)
+}
+
+// copy returns a copy of s which shares no transitions with s
+func (s *fileStatus) copy() *fileStatus {
+	if s == nil {
+		return nil
+	}
+	out := &fileStatus{Status: s.Status}
+	out.Transitions = append(out.Transitions, s.Transitions...)
+	out.Acknowledgements = append(out.Acknowledgements, s.Acknowledgements...)
+	return out
+}
+
+// validationStatus returns validated when file validates, otherwise draft
+func validationStatus(file *wire.File) string {
+	if file.Validate() != nil {
+		return statusDraft
+	}
+	return statusValidated
+}
+
+// fileResponse is a File along with its status
+type fileResponse struct {
+	*wire.File
+	Status *fileStatus `json:"status,omitempty"`
+}
+
+// problemConflict responds with 409 Conflict when err is a transitionError or errFileNotEditable and returns
+// true, otherwise it returns false
+func problemConflict(w http.ResponseWriter, err error) bool {
+	if _, ok := err.(*transitionError); !ok && err != errFileNotEditable {
+		return false
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusConflict)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+	return true
+}
+
+// transitionFile moves a File to status, validating it first, with its routing numbers checked against directory,
+// when it is approved
+func transitionFile(logger log.Logger, repo WireFileRepository, directory wire.RoutingDirectory, status string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w = wrapResponseWriter(logger, w, r)
+
+		fileId := getFileId(w, r)
+		if fileId == "" {
+			return
+		}
+		file, err := repo.getFile(fileId)
+		if err != nil {
+			moovhttp.Problem(w, err)
+			return
+		}
+		if file == nil {
+			moovhttp.Problem(w, fmt.Errorf("file=%s not found", fileId))
+			return
+		}
+		if status == statusApproved {
+			file.SetRoutingDirectory(directory)
+			if err := file.Validate(); err != nil {
+				moovhttp.Problem(w, err)
+				return
+			}
+		}
+		// the status is checked and moved under the lock of repo, so concurrent transitions can not both succeed
+		actor := moovhttp.GetUserID(r)
+		st, err := repo.updateFileStatus(fileId, func(st *fileStatus) error {
+			return st.transition(status, actor, time.Now())
+		})
+		if err != nil {
+			if !problemConflict(w, err) {
+				moovhttp.Problem(w, err)
+			}
+			return
+		}
+		if requestId := moovhttp.GetRequestID(r); requestId != "" {
+			logger.Log("files", fmt.Sprintf("file=%s is %s", fileId, status), "requestId", requestId)
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(fileResponse{File: file, Status: st})
+	}
+}
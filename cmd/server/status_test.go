@@ -0,0 +1,175 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/moov-io/wire"
+
+	"github.com/go-kit/kit/log"
+	"github.com/gorilla/mux"
+)
+
+func TestFileStatus__transition(t *testing.T) {
+	status := &fileStatus{}
+	now := time.Now()
+	for _, to := range []string{statusDraft, statusDraft, statusValidated, statusApproved, statusSent, statusRejected} {
+		if err := status.transition(to, "jane", now); err != nil {
+			t.Fatalf("to %s: %v", to, err)
+		}
+	}
+	if status.Status != statusRejected || len(status.Transitions) != 5 {
+		t.Errorf("unexpected status: %#v", status)
+	}
+	if tr := status.Transitions[2]; tr.Status != statusApproved || tr.Actor != "jane" {
+		t.Errorf("unexpected transition: %#v", tr)
+	}
+
+	if err := status.transition(statusAcknowledged, "", now); err == nil {
+		t.Error("rejected File was acknowledged")
+	}
+	status = &fileStatus{Status: statusDraft}
+	if err := status.transition(statusApproved, "", now); err == nil {
+		t.Error("draft File was approved")
+	} else if err.Error() != "File can not move from draft to approved" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if !(*fileStatus)(nil).editable() || (&fileStatus{Status: statusApproved}).editable() {
+		t.Error("unexpected editable")
+	}
+}
+
+func TestFiles__lifecycle(t *testing.T) {
+	repo := &memoryWireFileRepository{
+		files: make(map[string]*wire.File),
+	}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	serve := func(method, path string, body interface{}) *httptest.ResponseRecorder {
+		var buf bytes.Buffer
+		if body != nil {
+			if err := json.NewEncoder(&buf).Encode(body); err != nil {
+				t.Fatal(err)
+			}
+		}
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, &buf)
+		req.Header.Set("content-type", "application/json")
+		req.Header.Set("X-User-ID", "jane")
+		router.ServeHTTP(w, req)
+		w.Flush()
+		return w
+	}
+	decode := func(w *httptest.ResponseRecorder) *fileStatus {
+		var resp struct {
+			Status *fileStatus `json:"status"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp.Status
+	}
+
+	w := serve("POST", "/files/create", wire.File{ID: "foo", FEDWireMessages: []wire.FEDWireMessage{mockFEDWireMessage()}})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if status := decode(w); status == nil || status.Status != statusValidated {
+		t.Fatalf("unexpected status: %#v", status)
+	}
+
+	if w := serve("POST", "/files/foo/ack", nil); w.Code != http.StatusConflict {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	for _, step := range []string{"approve", "send", "ack"} {
+		w := serve("POST", "/files/foo/"+step, nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: bogus HTTP status: %d: %s", step, w.Code, w.Body.String())
+		}
+	}
+
+	// approved Files can not be changed
+	fwm := mockFEDWireMessage()
+	fwm.InputMessageAccountabilityData.InputSequenceNumber = "000002"
+	fwm.SenderReference = nil
+	if w := serve("POST", "/files/foo/FEDWireMessage", fwm); w.Code != http.StatusConflict {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	w = serve("GET", "/files", nil)
+	var files []struct {
+		ID     string      `json:"id"`
+		Status *fileStatus `json:"status"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&files); err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Status == nil || files[0].Status.Status != statusAcknowledged {
+		t.Fatalf("unexpected files: %#v", files)
+	}
+	if n := len(files[0].Status.Transitions); n != 4 || files[0].Status.Transitions[n-1].Actor != "jane" {
+		t.Errorf("unexpected transitions: %#v", files[0].Status.Transitions)
+	}
+}
+
+// TestFiles__concurrentTransitions validates only one of concurrent requests moves a File
+func TestFiles__concurrentTransitions(t *testing.T) {
+	repo := &memoryWireFileRepository{
+		files: make(map[string]*wire.File),
+	}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	file := &wire.File{ID: "foo", FEDWireMessages: []wire.FEDWireMessage{mockFEDWireMessage()}}
+	_, err := repo.saveFileWithStatus(file, func(status *fileStatus) error {
+		if err := status.transition(statusValidated, "jane", time.Now()); err != nil {
+			return err
+		}
+		return status.transition(statusApproved, "jane", time.Now())
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	codes := make([]int, 20)
+	for i := range codes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("POST", "/files/foo/send", nil))
+			codes[i] = w.Code
+		}(i)
+	}
+	wg.Wait()
+
+	sent := 0
+	for _, code := range codes {
+		switch code {
+		case http.StatusOK:
+			sent++
+		case http.StatusConflict:
+		default:
+			t.Errorf("bogus HTTP status: %d", code)
+		}
+	}
+	if sent != 1 {
+		t.Errorf("File was sent %d times", sent)
+	}
+	status, err := repo.getFileStatus("foo")
+	if err != nil || status == nil || status.Status != statusSent || len(status.Transitions) != 3 {
+		t.Errorf("status=%#v error=%v", status, err)
+	}
+}
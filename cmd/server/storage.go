@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"github.com/moov-io/wire"
 	"sync"
 )
@@ -10,13 +11,30 @@ type WireFileRepository interface {
 	getFiles() ([]*wire.File, error)
 	getFile(fileId string) (*wire.File, error)
 
+	// saveFile saves file, keeping its status
 	saveFile(file *wire.File) error
+	// saveFileWithStatus saves file along with its status after update, as in updateFileStatus, so the File is
+	// never stored without its status
+	saveFileWithStatus(file *wire.File, update func(*fileStatus) error) (*fileStatus, error)
 	deleteFile(fileId string) error
+
+	// getFileIdByIMAD returns the ID of the File holding the FEDWireMessage with imad, or an empty string when
+	// there is no such File
+	getFileIdByIMAD(imad string) (string, error)
+
+	// getFileStatus returns the status of a File, or nil when the File has no status
+	getFileStatus(fileId string) (*fileStatus, error)
+	// updateFileStatus calls update with the status of a File, which is empty when the File has none, and saves
+	// and returns the status unless update returns an error. The status is read, updated and saved under one
+	// lock, so a check update makes holds when the status is saved.
+	updateFileStatus(fileId string, update func(*fileStatus) error) (*fileStatus, error)
 }
 
 type memoryWireFileRepository struct {
-	mu    sync.Mutex
-	files map[string]*wire.File
+	mu       sync.Mutex
+	files    map[string]*wire.File
+	statuses map[string]*fileStatus
+	index    *messageIndex
 }
 
 func (r *memoryWireFileRepository) getFiles() ([]*wire.File, error) {
@@ -25,8 +43,7 @@ func (r *memoryWireFileRepository) getFiles() ([]*wire.File, error) {
 
 	var out []*wire.File
 	for _, v := range r.files {
-		f := *v
-		out = append(out, &f)
+		out = append(out, copyFile(v))
 	}
 	return out, nil
 }
@@ -37,22 +54,54 @@ func (r *memoryWireFileRepository) getFile(fileId string) (*wire.File, error) {
 
 	for i := range r.files {
 		if r.files[i].ID == fileId {
-			f := *r.files[i]
-			return &f, nil
+			return copyFile(r.files[i]), nil
 		}
 	}
 	return nil, nil
 }
 
 func (r *memoryWireFileRepository) saveFile(file *wire.File) error {
+	_, err := r.saveFileWithStatus(file, nil)
+	return err
+}
+
+func (r *memoryWireFileRepository) saveFileWithStatus(file *wire.File, update func(*fileStatus) error) (*fileStatus, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	if file.ID == "" {
-		return errors.New("empty Wire File ID")
+		return nil, errors.New("empty Wire File ID")
 	}
-	r.files[file.ID] = file
-	return nil
+	if r.index == nil {
+		r.index = newMessageIndex()
+	}
+	if err := r.index.check(file); err != nil {
+		return nil, err
+	}
+	status := r.statuses[file.ID].copy()
+	if update != nil {
+		if status == nil {
+			status = &fileStatus{}
+		}
+		if err := update(status); err != nil {
+			return nil, err
+		}
+		if r.statuses == nil {
+			r.statuses = make(map[string]*fileStatus)
+		}
+		r.statuses[file.ID] = status.copy()
+	}
+	r.files[file.ID] = copyFile(file)
+	r.index.add(file)
+	return status, nil
+}
+
+// copyFile returns a copy of file which shares no FEDWireMessages with file, so replacing or appending a
+// FEDWireMessage of one does not change the other
+func copyFile(file *wire.File) *wire.File {
+	f := *file
+	f.FEDWireMessages = append([]wire.FEDWireMessage(nil), file.FEDWireMessages...)
+	return &f
 }
 
 func (r *memoryWireFileRepository) deleteFile(fileId string) error {
@@ -64,6 +113,48 @@ func (r *memoryWireFileRepository) deleteFile(fileId string) error {
 	}
 
 	delete(r.files, fileId)
+	delete(r.statuses, fileId)
+	if r.index != nil {
+		r.index.remove(fileId)
+	}
 
 	return nil
 }
+
+func (r *memoryWireFileRepository) getFileIdByIMAD(imad string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.index == nil {
+		return "", nil
+	}
+	return r.index.fileID(imad), nil
+}
+
+func (r *memoryWireFileRepository) getFileStatus(fileId string) (*fileStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.statuses[fileId].copy(), nil
+}
+
+func (r *memoryWireFileRepository) updateFileStatus(fileId string, update func(*fileStatus) error) (*fileStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.files[fileId]; !ok {
+		return nil, fmt.Errorf("file=%s not found", fileId)
+	}
+	status := r.statuses[fileId].copy()
+	if status == nil {
+		status = &fileStatus{}
+	}
+	if err := update(status); err != nil {
+		return nil, err
+	}
+	if r.statuses == nil {
+		r.statuses = make(map[string]*fileStatus)
+	}
+	r.statuses[fileId] = status.copy()
+	return status, nil
+}
@@ -4,12 +4,14 @@ import (
 	"github.com/moov-io/base"
 	"github.com/moov-io/wire"
 	"testing"
+	"time"
 )
 
 type testWireFileRepository struct {
 	err error
 
-	file *wire.File
+	file   *wire.File
+	status *fileStatus
 }
 
 func (r *testWireFileRepository) getFiles() ([]*wire.File, error) {
@@ -33,14 +35,68 @@ func (r *testWireFileRepository) saveFile(file *wire.File) error {
 	return r.err
 }
 
+func (r *testWireFileRepository) saveFileWithStatus(file *wire.File, update func(*fileStatus) error) (*fileStatus, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	status := r.status.copy()
+	if status == nil {
+		status = &fileStatus{}
+	}
+	if err := update(status); err != nil {
+		return nil, err
+	}
+	r.file, r.status = file, status
+	return status.copy(), nil
+}
+
 func (r *testWireFileRepository) deleteFile(fileId string) error {
 	return r.err
 }
 
+func (r *testWireFileRepository) getFileIdByIMAD(imad string) (string, error) {
+	if r.err != nil || r.file == nil {
+		return "", r.err
+	}
+	for i := range r.file.FEDWireMessages {
+		if key, _ := messageKeys(&r.file.FEDWireMessages[i]); key == imad {
+			return r.file.ID, nil
+		}
+	}
+	return "", nil
+}
+
+func (r *testWireFileRepository) getFileStatus(fileId string) (*fileStatus, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.status, nil
+}
+
+func (r *testWireFileRepository) updateFileStatus(fileId string, update func(*fileStatus) error) (*fileStatus, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	status := r.status.copy()
+	if status == nil {
+		status = &fileStatus{}
+	}
+	if err := update(status); err != nil {
+		return nil, err
+	}
+	r.status = status
+	return status.copy(), nil
+}
+
 func TestMemoryStorage(t *testing.T) {
-	repo := &memoryWireFileRepository{
+	testStorage(t, &memoryWireFileRepository{
 		files: make(map[string]*wire.File),
-	}
+	})
+}
+
+// testStorage runs the tests every WireFileRepository must pass against repo, which must be empty
+func testStorage(t *testing.T, repo WireFileRepository) {
+	t.Helper()
 
 	files, err := repo.getFiles()
 	if err != nil || len(files) != 0 {
@@ -70,6 +126,20 @@ func TestMemoryStorage(t *testing.T) {
 		t.Errorf("file mis-match")
 	}
 
+	// the File saved and the Files returned share no FEDWireMessages
+	f.FEDWireMessages[0].Amount = nil
+	file.FEDWireMessages[0].SenderReference = nil
+	file.AddFEDWireMessage(file.FEDWireMessages[0])
+	if file, err := repo.getFile(f.ID); err != nil || len(file.FEDWireMessages) != 1 {
+		t.Fatalf("file=%#v error=%v", file, err)
+	} else if fwm := file.FEDWireMessage(); fwm.Amount == nil || fwm.SenderReference == nil {
+		t.Errorf("the saved File was changed: %#v", fwm)
+	}
+	if f, err = readFile("fedWireMessage-CustomerTransfer.txt"); err != nil {
+		t.Fatal(err)
+	}
+	f.ID = file.ID
+
 	if err := repo.deleteFile(f.ID); err != nil {
 		t.Error(err)
 	}
@@ -77,4 +147,74 @@ func TestMemoryStorage(t *testing.T) {
 	if err != nil || len(files) != 0 {
 		t.Errorf("files=%#v error=%v", files, err)
 	}
+
+	// a FEDWireMessage in another File with the same IMAD is a duplicate
+	if err := repo.saveFile(f); err != nil {
+		t.Fatal(err)
+	}
+	dup := *f
+	dup.ID = base.ID()
+	if err := repo.saveFile(&dup); err == nil {
+		t.Error("expected duplicate error")
+	} else if _, ok := err.(*duplicateMessageError); !ok {
+		t.Errorf("unexpected error: %T %v", err, err)
+	}
+	if err := repo.deleteFile(f.ID); err != nil {
+		t.Error(err)
+	}
+	if err := repo.saveFile(&dup); err != nil {
+		t.Errorf("File is a duplicate of a deleted File: %v", err)
+	}
+
+	imad, _ := messageKeys(dup.FEDWireMessage())
+	if id, err := repo.getFileIdByIMAD(imad); err != nil || id != dup.ID {
+		t.Errorf("id=%s error=%v", id, err)
+	}
+
+	// the status of a File is kept when the File is saved again
+	validate := func(status *fileStatus) error {
+		return status.transition(statusValidated, "jane", time.Now())
+	}
+	if _, err := repo.updateFileStatus(dup.ID, validate); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.saveFile(&dup); err != nil {
+		t.Fatal(err)
+	}
+	if status, err := repo.getFileStatus(dup.ID); err != nil || status == nil || status.Status != statusValidated {
+		t.Errorf("status=%#v error=%v", status, err)
+	}
+	if err := repo.deleteFile(dup.ID); err != nil {
+		t.Fatal(err)
+	}
+	if status, err := repo.getFileStatus(dup.ID); err != nil || status != nil {
+		t.Errorf("status=%#v error=%v", status, err)
+	}
+	if _, err := repo.updateFileStatus(dup.ID, validate); err == nil {
+		t.Error("saved the status of a deleted File")
+	}
+
+	// a File is saved along with its status, and neither is saved when update fails
+	notEditable := func(status *fileStatus) error {
+		return errFileNotEditable
+	}
+	if _, err := repo.saveFileWithStatus(&dup, notEditable); err != errFileNotEditable {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if file, err := repo.getFile(dup.ID); file != nil || err != nil {
+		t.Errorf("file=%#v error=%v", file, err)
+	}
+	if status, err := repo.saveFileWithStatus(&dup, validate); err != nil || status.Status != statusValidated {
+		t.Fatalf("status=%#v error=%v", status, err)
+	}
+	if status, err := repo.getFileStatus(dup.ID); err != nil || status == nil || status.Status != statusValidated {
+		t.Errorf("status=%#v error=%v", status, err)
+	}
+	if err := repo.deleteFile(dup.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if file, err := repo.getFile(base.ID()); file != nil || err != nil {
+		t.Errorf("file=%#v error=%v", file, err)
+	}
 }
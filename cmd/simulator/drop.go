@@ -0,0 +1,76 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/moov-io/wire/internal/atomicfile"
+)
+
+// dropDirectory submits the FAIM files dropped into dir, writing the acknowledgements of each file to a file of
+// the same name with an .ack extension in acks. Files whose names begin with a dot are skipped, so a file may be
+// written under a dot name and renamed once complete, as are .ack files.
+type dropDirectory struct {
+	logger log.Logger
+	dir    string
+	acks   string
+	sim    *simulator
+}
+
+// watch submits the files in the directory every interval until stop is closed
+func (d *dropDirectory) watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := d.sweep(); err != nil {
+			d.logger.Log("drop", fmt.Sprintf("problem reading %s: %v", d.dir, err))
+		}
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// sweep submits each file in the directory and removes it once its acknowledgements are written
+func (d *dropDirectory) sweep() error {
+	infos, err := ioutil.ReadDir(d.dir)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") || strings.HasSuffix(info.Name(), ".ack") {
+			continue
+		}
+		if err := d.submit(info.Name()); err != nil {
+			d.logger.Log("drop", fmt.Sprintf("problem submitting %s: %v", info.Name(), err))
+		}
+	}
+	return nil
+}
+
+func (d *dropDirectory) submit(name string) error {
+	path := filepath.Join(d.dir, name)
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var acks strings.Builder
+	for _, message := range splitMessages(strings.Split(string(bs), "\n")) {
+		acks.WriteString(d.sim.submit(message))
+	}
+	if err := atomicfile.WriteFile(filepath.Join(d.acks, name+".ack"), []byte(acks.String())); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
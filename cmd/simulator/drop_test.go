@@ -0,0 +1,92 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/moov-io/wire"
+)
+
+func TestDropDirectory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wire-simulator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	acks := filepath.Join(dir, "acks")
+	if err := os.Mkdir(acks, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	sim, _ := newTestSimulator(failures{})
+	drop := &dropDirectory{logger: log.NewNopLogger(), dir: dir, acks: acks, sim: sim}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "wires.txt"), []byte(mockMessage(t)+mockMessage(t)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, ".partial.txt"), []byte(mockMessage(t)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := drop.sweep(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "wires.txt")); !os.IsNotExist(err) {
+		t.Errorf("expected wires.txt to be removed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, ".partial.txt")); err != nil {
+		t.Errorf("expected .partial.txt to be skipped: %v", err)
+	}
+
+	bs, err := ioutil.ReadFile(filepath.Join(acks, "wires.txt.ack"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := wire.NewReader(strings.NewReader(string(bs))).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(file.FEDWireMessages); n != 2 {
+		t.Fatalf("read %d acknowledgements", n)
+	}
+	if ew := file.FEDWireMessages[0].ErrorWire; ew != nil {
+		t.Errorf("unexpected ErrorWire: %#v", ew)
+	}
+	if ew := file.FEDWireMessages[1].ErrorWire; ew == nil || ew.ErrorCategory != wire.ErrorCategoryDuplicateIMAD {
+		t.Errorf("unexpected ErrorWire: %#v", ew)
+	}
+}
+
+func TestDirectoryParticipant(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wire-simulator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	sim := newSimulator(log.NewNopLogger(), &directoryParticipant{dir: dir}, failures{})
+	sim.submit(mockMessage(t))
+
+	names, err := filepath.Glob(filepath.Join(dir, "231380104", "*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 {
+		t.Fatalf("found %v", names)
+	}
+	bs, err := ioutil.ReadFile(names[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(bs), wire.TagMessageDisposition) || !strings.HasSuffix(string(bs), mockMessage(t)) {
+		t.Errorf("unexpected message:\n%s", string(bs))
+	}
+}
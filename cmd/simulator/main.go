@@ -0,0 +1,121 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// simulator is a local stand-in for the Fedwire Funds Service, for integration testing. FEDWireMessages are
+// submitted as FAIM text over a socket or by dropping files into a directory. Each is validated, assigned an
+// OMAD and receipt timestamp, and acknowledged or rejected with an ErrorWire {1130}. Accepted FEDWireMessages are
+// forwarded to the simulated receiving participant.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/moov-io/wire"
+)
+
+var (
+	flagSocketAddr = flag.String("socket.addr", "localhost:8090", "Address to accept FAIM text on, disabled when empty")
+
+	flagDropDir      = flag.String("drop.dir", "", "Directory to submit dropped FAIM files from, disabled when empty")
+	flagDropAcks     = flag.String("drop.acks", "", "Directory to write the acknowledgements of dropped files to, defaults to the acks directory of -drop.dir")
+	flagDropInterval = flag.Duration("drop.interval", time.Second, "How often to check -drop.dir for files")
+
+	flagReceiverDir = flag.String("receiver.dir", "", "Directory to forward accepted FEDWireMessages to, they are only logged when empty")
+
+	flagSchedule    = flag.Bool("schedule", false, "Reject FEDWireMessages outside the Fedwire operating schedule")
+	flagFailCutoff  = flag.Bool("fail.cutoff", false, "Reject every FEDWireMessage with a Cutoff Hour Error (W)")
+	flagFailBalance = flag.Int64("fail.balance", 0, "Amount in cents each sender may send per cycle date before an Insufficient Balance (F) error, unlimited when zero")
+	flagLogFormat   = flag.String("log.format", "", "Format for log lines (Options: json, plain")
+)
+
+func main() {
+	flag.Parse()
+
+	var logger log.Logger
+	if strings.ToLower(*flagLogFormat) == "json" {
+		logger = log.NewJSONLogger(os.Stderr)
+	} else {
+		logger = log.NewLogfmtLogger(os.Stderr)
+	}
+	logger = log.With(logger, "ts", log.DefaultTimestampUTC)
+	logger = log.With(logger, "caller", log.DefaultCaller)
+
+	logger.Log("startup", fmt.Sprintf("Starting wire simulator version %s", wire.Version))
+
+	if *flagSocketAddr == "" && *flagDropDir == "" {
+		logger.Log("startup", "one of -socket.addr or -drop.dir is required")
+		os.Exit(1)
+	}
+
+	var receiver participant = &logParticipant{logger: logger}
+	if *flagReceiverDir != "" {
+		receiver = &directoryParticipant{dir: *flagReceiverDir}
+		logger.Log("startup", fmt.Sprintf("forwarding accepted FEDWireMessages to %s", *flagReceiverDir))
+	}
+	fails := failures{
+		cutoff:  *flagFailCutoff,
+		balance: *flagFailBalance,
+	}
+	if *flagSchedule {
+		fails.schedule = wire.NewSchedule()
+	}
+	sim := newSimulator(logger, receiver, fails)
+
+	// Channel for errors
+	errs := make(chan error)
+
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+		errs <- fmt.Errorf("%s", <-c)
+	}()
+
+	if *flagSocketAddr != "" {
+		ln, err := net.Listen("tcp", *flagSocketAddr)
+		if err != nil {
+			logger.Log("startup", fmt.Sprintf("problem listening on %s: %v", *flagSocketAddr, err))
+			os.Exit(1)
+		}
+		defer ln.Close()
+		logger.Log("startup", fmt.Sprintf("accepting FAIM text on %s", ln.Addr()))
+		go func() {
+			errs <- serveSocket(logger, ln, sim)
+		}()
+	}
+
+	if *flagDropDir != "" {
+		acks := *flagDropAcks
+		if acks == "" {
+			acks = filepath.Join(*flagDropDir, "acks")
+		}
+		if err := os.MkdirAll(acks, 0755); err != nil {
+			logger.Log("startup", fmt.Sprintf("problem creating acknowledgements directory: %v", err))
+			os.Exit(1)
+		}
+		drop := &dropDirectory{
+			logger: logger,
+			dir:    *flagDropDir,
+			acks:   acks,
+			sim:    sim,
+		}
+		stop := make(chan struct{})
+		defer close(stop)
+		logger.Log("startup", fmt.Sprintf("submitting files dropped into %s", *flagDropDir))
+		go drop.watch(*flagDropInterval, stop)
+	}
+
+	// Block/Wait for an error
+	if err := <-errs; err != nil {
+		logger.Log("exit", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-kit/kit/log"
+	"github.com/moov-io/wire"
+	"github.com/moov-io/wire/internal/atomicfile"
+)
+
+// participant is the simulated receiving participant accepted FEDWireMessages are forwarded to
+type participant interface {
+	// receive delivers message, the output message identified by omad, to the participant with routingNumber
+	receive(routingNumber string, omad *wire.OutputMessageAccountabilityData, message string) error
+}
+
+// logParticipant logs the FEDWireMessages forwarded to it
+type logParticipant struct {
+	logger log.Logger
+}
+
+func (p *logParticipant) receive(routingNumber string, omad *wire.OutputMessageAccountabilityData, message string) error {
+	p.logger.Log("participant", fmt.Sprintf("%s received OMAD %s", routingNumber, omadKey(omad)))
+	return nil
+}
+
+// directoryParticipant writes the FEDWireMessages forwarded to it into a directory per routing number, one file
+// per OMAD
+type directoryParticipant struct {
+	dir string
+}
+
+func (p *directoryParticipant) receive(routingNumber string, omad *wire.OutputMessageAccountabilityData, message string) error {
+	dir := filepath.Join(p.dir, strings.TrimSpace(routingNumber))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(filepath.Join(dir, omadKey(omad)+".txt"), []byte(message))
+}
@@ -0,0 +1,298 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+// applicationID is the OutputFRBApplicationIdentification and ReceiptApplicationIdentification of the output
+// messages of the simulator
+const applicationID = "SIM1"
+
+// ErrorCodes of the ErrorWire {1130} of rejected FEDWireMessages
+const (
+	// errorCodeInvalid is a FEDWireMessage which failed to parse or validate
+	errorCodeInvalid = "001"
+	// errorCodeDuplicateIMAD is a FEDWireMessage with the IMAD of a FEDWireMessage already received
+	errorCodeDuplicateIMAD = "002"
+	// errorCodeCutoff is a FEDWireMessage received after the cutoff of its BusinessFunctionCode
+	errorCodeCutoff = "003"
+	// errorCodeInsufficientBalance is a FEDWireMessage for more than the remaining balance of the sender
+	errorCodeInsufficientBalance = "004"
+)
+
+var (
+	errNoFEDWireMessage      = errors.New("no FEDWireMessage found")
+	errDuplicateIMAD         = errors.New("IMAD has already been received")
+	errCutoff                = errors.New("received after cutoff hour")
+	errInsufficientBalance   = errors.New("insufficient balance")
+	errorDescriptionReplacer = regexp.MustCompile(`[^ A-Za-z0-9_@./#&+-]+`)
+)
+
+// failures configures the FEDWireMessages the simulator rejects beyond those which are invalid or duplicate
+type failures struct {
+	// schedule, when not nil, rejects FEDWireMessages outside the cycle date of their InputCycleDate or received
+	// after the cutoff of their BusinessFunctionCode
+	schedule *wire.Schedule
+	// cutoff rejects every FEDWireMessage with a Cutoff Hour Error (W), as if the Fedwire Funds Service had closed
+	cutoff bool
+	// balance is the total Amount, in cents, each sender may send on a cycle date before value FEDWireMessages are
+	// rejected with an Insufficient Balance (F) error. The balance is unlimited when zero.
+	balance int64
+}
+
+// simulator plays the part of the Fedwire Funds Service. Each FEDWireMessage submitted is validated, assigned an
+// OutputMessageAccountabilityData (OMAD) and ReceiptTimeStamp and acknowledged to its sender. Accepted
+// FEDWireMessages are forwarded to the receiving participant, rejected FEDWireMessages are acknowledged with an
+// ErrorWire {1130}.
+type simulator struct {
+	logger   log.Logger
+	receiver participant
+	failures failures
+	now      func() time.Time
+
+	mu sync.Mutex
+	// cycleDate (CCYYMMDD) is the cycle date of OMADs, sequences and sent
+	cycleDate string
+	// imads are the IMADs received
+	imads map[string]bool
+	// sequences is the latest OutputSequenceNumber of each OutputDestinationID on cycleDate
+	sequences map[string]int
+	// sent is the Amount, in cents, each sender ABA has sent on cycleDate
+	sent map[string]int64
+}
+
+func newSimulator(logger log.Logger, receiver participant, failures failures) *simulator {
+	return &simulator{
+		logger:    logger,
+		receiver:  receiver,
+		failures:  failures,
+		now:       time.Now,
+		imads:     make(map[string]bool),
+		sequences: make(map[string]int),
+		sent:      make(map[string]int64),
+	}
+}
+
+// submit processes the FEDWireMessage in message, FAIM text, and returns the output message acknowledging it to
+// the sender: message with MessageDisposition {1100}, ReceiptTimeStamp {1110}, OutputMessageAccountabilityData
+// {1120} and, when rejected, ErrorWire {1130} prepended.
+func (s *simulator) submit(message string) string {
+	fwm, err := readMessage(message)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.startCycle(now)
+
+	var ew *wire.ErrorWire
+	if err != nil {
+		ew = newErrorWire(wire.ErrorCategoryDataError, errorCodeInvalid, err)
+	} else {
+		ew = s.check(fwm, now)
+	}
+
+	md := wire.NewMessageDisposition()
+	if fwm != nil && fwm.SenderSupplied != nil {
+		md.TestProductionCode = fwm.SenderSupplied.TestProductionCode
+		md.MessageDuplicationCode = fwm.SenderSupplied.MessageDuplicationCode
+	}
+	rts := wire.NewReceiptTimeStamp()
+	rts.ReceiptDate = now.Format("0102")
+	rts.ReceiptTime = now.Format("1504")
+	rts.ReceiptApplicationIdentification = applicationID
+	omad := s.omad(senderDestinationID(fwm), now)
+
+	if ew != nil {
+		md.MessageStatusIndicator = wire.MessageStatusRejected
+		s.logger.Log("simulator", fmt.Sprintf("rejected IMAD %s: %s%s %s", imadKey(fwm), ew.ErrorCategory, ew.ErrorCode, ew.ErrorDescription))
+		return outputMessage(message, md, rts, omad, ew)
+	}
+
+	incoming := *md
+	if isValue(fwm) {
+		md.MessageStatusIndicator = wire.MessageStatusSuccessfulValue
+		incoming.MessageStatusIndicator = wire.MessageStatusIncomingValue
+	} else {
+		md.MessageStatusIndicator = wire.MessageStatusSuccessfulNonValue
+		incoming.MessageStatusIndicator = wire.MessageStatusIncomingNonValue
+	}
+
+	routingNumber := fwm.ReceiverDepositoryInstitution.ReceiverABANumber
+	forward := s.omad(destinationID(routingNumber), now)
+	if err := s.receiver.receive(routingNumber, forward, outputMessage(message, &incoming, rts, forward, nil)); err != nil {
+		s.logger.Log("simulator", fmt.Sprintf("problem forwarding IMAD %s to %s: %v", imadKey(fwm), routingNumber, err))
+	}
+	s.logger.Log("simulator", fmt.Sprintf("accepted IMAD %s as OMAD %s", imadKey(fwm), omadKey(omad)))
+	return outputMessage(message, md, rts, omad, nil)
+}
+
+// startCycle begins the cycle date of now, resetting the OutputSequenceNumbers and balances of the prior one
+func (s *simulator) startCycle(now time.Time) {
+	cycleDate := now.Format("20060102")
+	if s.failures.schedule != nil {
+		cycleDate = s.failures.schedule.CycleDate(now).Format("20060102")
+	}
+	if cycleDate == s.cycleDate {
+		return
+	}
+	s.cycleDate = cycleDate
+	s.sequences = make(map[string]int)
+	s.sent = make(map[string]int64)
+}
+
+// check returns the ErrorWire rejecting the valid FEDWireMessage fwm, or nil when fwm is accepted. The IMAD of an
+// accepted FEDWireMessage is used, so it may not be sent again other than as a resend, while a rejected
+// FEDWireMessage may be corrected and sent again with its IMAD. The Amount of an accepted value FEDWireMessage
+// is deducted from the balance of the sender.
+func (s *simulator) check(fwm *wire.FEDWireMessage, now time.Time) *wire.ErrorWire {
+	imad := imadKey(fwm)
+	if s.imads[imad] && fwm.SenderSupplied.MessageDuplicationCode != wire.MessageDuplicationResend {
+		return newErrorWire(wire.ErrorCategoryDuplicateIMAD, errorCodeDuplicateIMAD, errDuplicateIMAD)
+	}
+
+	if s.failures.cutoff {
+		return newErrorWire(wire.ErrorCategoryCutoffHour, errorCodeCutoff, errCutoff)
+	}
+	if s.failures.schedule != nil {
+		if err := s.failures.schedule.Check(fwm, now); err != nil {
+			if base.Match(err, wire.ErrCutoffHour) {
+				return newErrorWire(wire.ErrorCategoryCutoffHour, errorCodeCutoff, errCutoff)
+			}
+			return newErrorWire(wire.ErrorCategoryDataError, errorCodeInvalid, err)
+		}
+	}
+
+	if s.failures.balance > 0 && isValue(fwm) {
+		sender := fwm.SenderDepositoryInstitution.SenderABANumber
+		amount, _ := strconv.ParseInt(fwm.Amount.Amount, 10, 64)
+		if s.sent[sender]+amount > s.failures.balance {
+			return newErrorWire(wire.ErrorCategoryInsufficientBalance, errorCodeInsufficientBalance, errInsufficientBalance)
+		}
+		s.sent[sender] += amount
+	}
+	s.imads[imad] = true
+	return nil
+}
+
+// omad returns the next OutputMessageAccountabilityData of destinationID on the current cycle date
+func (s *simulator) omad(destinationID string, now time.Time) *wire.OutputMessageAccountabilityData {
+	s.sequences[destinationID]++
+	omad := wire.NewOutputMessageAccountabilityData()
+	omad.OutputCycleDate = s.cycleDate
+	omad.OutputDestinationID = destinationID
+	omad.OutputSequenceNumber = fmt.Sprintf("%06d", s.sequences[destinationID])
+	omad.OutputDate = now.Format("0102")
+	omad.OutputTime = now.Format("1504")
+	omad.OutputFRBApplicationIdentification = applicationID
+	return omad
+}
+
+// readMessage reads and validates the FEDWireMessage in message. A FEDWireMessage is returned, when one could be
+// parsed, along with the first problem found with it.
+func readMessage(message string) (*wire.FEDWireMessage, error) {
+	fwm, err := wire.NewReader(strings.NewReader(message)).Next()
+	if err == io.EOF {
+		return nil, errNoFEDWireMessage
+	}
+	if el, ok := err.(base.ErrorList); ok && len(el) > 0 {
+		return fwm, el[0]
+	}
+	if err != nil {
+		return fwm, err
+	}
+	if errs := fwm.ValidateAll(); len(errs) > 0 {
+		return fwm, errs[0]
+	}
+	return fwm, nil
+}
+
+// newErrorWire returns the ErrorWire of a rejection, describing err within the 35 characters of ErrorDescription
+func newErrorWire(category, code string, err error) *wire.ErrorWire {
+	ew := wire.NewErrorWire()
+	ew.ErrorCategory = category
+	ew.ErrorCode = code
+	description := strings.Join(strings.Fields(errorDescriptionReplacer.ReplaceAllString(err.Error(), " ")), " ")
+	if len(description) > 35 {
+		description = strings.TrimSpace(description[:35])
+	}
+	ew.ErrorDescription = description
+	return ew
+}
+
+// outputMessage returns message with the output tags prepended
+func outputMessage(message string, md *wire.MessageDisposition, rts *wire.ReceiptTimeStamp, omad *wire.OutputMessageAccountabilityData, ew *wire.ErrorWire) string {
+	var buf strings.Builder
+	buf.WriteString(md.String() + "\n")
+	buf.WriteString(rts.String() + "\n")
+	buf.WriteString(omad.String() + "\n")
+	if ew != nil {
+		buf.WriteString(ew.String() + "\n")
+	}
+	buf.WriteString(message)
+	return buf.String()
+}
+
+// isValue returns true when fwm moves funds, false for a service message or a zero Amount
+func isValue(fwm *wire.FEDWireMessage) bool {
+	if fwm.TypeSubType != nil && fwm.TypeSubType.SubTypeCode == wire.SSIServiceMessage {
+		return false
+	}
+	amount, _ := strconv.ParseInt(fwm.Amount.Amount, 10, 64)
+	return amount > 0
+}
+
+// imadKey returns the IMAD of fwm as one string
+func imadKey(fwm *wire.FEDWireMessage) string {
+	if fwm == nil || fwm.InputMessageAccountabilityData == nil {
+		return ""
+	}
+	imad := fwm.InputMessageAccountabilityData
+	return imad.InputCycleDate + imad.InputSource + imad.InputSequenceNumber
+}
+
+// omadKey returns the OMAD of omad as one string
+func omadKey(omad *wire.OutputMessageAccountabilityData) string {
+	return omad.OutputCycleDate + omad.OutputDestinationID + omad.OutputSequenceNumber
+}
+
+// senderDestinationID returns the OutputDestinationID of the sender of fwm, the InputSource of its IMAD
+func senderDestinationID(fwm *wire.FEDWireMessage) string {
+	if fwm == nil {
+		return destinationID("")
+	}
+	if imad := fwm.InputMessageAccountabilityData; imad != nil && strings.TrimSpace(imad.InputSource) != "" {
+		return imad.InputSource
+	}
+	if fwm.SenderDepositoryInstitution != nil {
+		return destinationID(fwm.SenderDepositoryInstitution.SenderABANumber)
+	}
+	return destinationID("")
+}
+
+// destinationID returns the OutputDestinationID of the participant with routingNumber. The simulator has no
+// logical terminals, so the first eight digits of the routing number are used.
+func destinationID(routingNumber string) string {
+	if routingNumber == "" {
+		return "UNKNOWN"
+	}
+	if len(routingNumber) > 8 {
+		return routingNumber[:8]
+	}
+	return routingNumber
+}
@@ -0,0 +1,239 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/moov-io/wire"
+)
+
+// memoryParticipant holds the output messages forwarded to it
+type memoryParticipant struct {
+	messages map[string][]string
+}
+
+func (p *memoryParticipant) receive(routingNumber string, omad *wire.OutputMessageAccountabilityData, message string) error {
+	if p.messages == nil {
+		p.messages = make(map[string][]string)
+	}
+	p.messages[routingNumber] = append(p.messages[routingNumber], message)
+	return nil
+}
+
+// mockMessage returns the FAIM text of the sample CustomerTransfer with each replacement applied
+func mockMessage(t *testing.T, replacements ...string) string {
+	bs, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := strings.TrimRight(string(bs), "\r\n") + "\n"
+	return strings.NewReplacer(replacements...).Replace(message)
+}
+
+func newTestSimulator(fails failures) (*simulator, *memoryParticipant) {
+	receiver := &memoryParticipant{}
+	sim := newSimulator(log.NewNopLogger(), receiver, fails)
+	sim.now = func() time.Time { return time.Date(2019, time.April, 10, 12, 30, 0, 0, time.UTC) }
+	return sim, receiver
+}
+
+// readOutputMessage reads the FEDWireMessage of an output message, which echoes any tags of the submitted
+// FEDWireMessage which failed to parse
+func readOutputMessage(t *testing.T, message string) *wire.FEDWireMessage {
+	t.Helper()
+	fwm, err := wire.NewReader(strings.NewReader(message)).Next()
+	if fwm == nil {
+		t.Fatalf("%v\n%s", err, message)
+	}
+	return fwm
+}
+
+func TestSimulator__accept(t *testing.T) {
+	sim, receiver := newTestSimulator(failures{})
+
+	message := mockMessage(t)
+	ack := readOutputMessage(t, sim.submit(message))
+	if ack.ErrorWire != nil {
+		t.Fatalf("unexpected ErrorWire: %#v", ack.ErrorWire)
+	}
+	if ack.MessageDisposition.MessageStatusIndicator != wire.MessageStatusSuccessfulValue {
+		t.Errorf("MessageStatusIndicator=%q", ack.MessageDisposition.MessageStatusIndicator)
+	}
+	if rts := ack.ReceiptTimeStamp; rts.ReceiptDate != "0410" || rts.ReceiptTime != "1230" || rts.ReceiptApplicationIdentification != applicationID {
+		t.Errorf("unexpected ReceiptTimeStamp: %#v", rts)
+	}
+	if got := omadKey(ack.OutputMessageAccountabilityData); got != "20190410Source08000001" {
+		t.Errorf("OMAD=%q", got)
+	}
+	if err := ack.OutputMessageAccountabilityData.Validate(); err != nil {
+		t.Error(err)
+	}
+	if ack.InputMessageAccountabilityData.InputSequenceNumber != "000001" {
+		t.Errorf("unexpected IMAD: %#v", ack.InputMessageAccountabilityData)
+	}
+
+	forwarded := receiver.messages["231380104"]
+	if len(forwarded) != 1 {
+		t.Fatalf("forwarded %d messages", len(forwarded))
+	}
+	if !strings.HasSuffix(forwarded[0], message) {
+		t.Errorf("forwarded message does not end with the submitted message:\n%s", forwarded[0])
+	}
+	incoming := readOutputMessage(t, forwarded[0])
+	if incoming.MessageDisposition.MessageStatusIndicator != wire.MessageStatusIncomingValue {
+		t.Errorf("MessageStatusIndicator=%q", incoming.MessageDisposition.MessageStatusIndicator)
+	}
+	if got := omadKey(incoming.OutputMessageAccountabilityData); got != "2019041023138010000001" {
+		t.Errorf("OMAD=%q", got)
+	}
+
+	// the next message of the sender has the next OutputSequenceNumber
+	ack = readOutputMessage(t, sim.submit(mockMessage(t, "Source08000001", "Source08000002")))
+	if got := ack.OutputMessageAccountabilityData.OutputSequenceNumber; got != "000002" {
+		t.Errorf("OutputSequenceNumber=%q", got)
+	}
+
+	// a resend of an IMAD is not a duplicate
+	ack = readOutputMessage(t, sim.submit(mockMessage(t, "{1500}30User ReqT ", "{1500}30User ReqTP")))
+	if ack.ErrorWire != nil {
+		t.Errorf("unexpected ErrorWire: %#v", ack.ErrorWire)
+	}
+}
+
+func TestSimulator__reject(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		failures failures
+		messages []string
+		category string
+		code     string
+	}{
+		{
+			name:     "invalid",
+			messages: []string{mockMessage(t, "{2000}000001234567", "{2000}00000123456Z")},
+			category: wire.ErrorCategoryDataError,
+			code:     errorCodeInvalid,
+		},
+		{
+			name:     "unparsable",
+			messages: []string{mockMessage(t, "{1510}1000", "{1510}10")},
+			category: wire.ErrorCategoryDataError,
+			code:     errorCodeInvalid,
+		},
+		{
+			name:     "duplicate IMAD",
+			messages: []string{mockMessage(t), mockMessage(t)},
+			category: wire.ErrorCategoryDuplicateIMAD,
+			code:     errorCodeDuplicateIMAD,
+		},
+		{
+			name:     "cutoff",
+			failures: failures{cutoff: true},
+			messages: []string{mockMessage(t)},
+			category: wire.ErrorCategoryCutoffHour,
+			code:     errorCodeCutoff,
+		},
+		{
+			name:     "insufficient balance",
+			failures: failures{balance: 2000000},
+			messages: []string{mockMessage(t), mockMessage(t, "Source08000001", "Source08000002")},
+			category: wire.ErrorCategoryInsufficientBalance,
+			code:     errorCodeInsufficientBalance,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sim, receiver := newTestSimulator(tc.failures)
+			var out string
+			for _, message := range tc.messages {
+				out = sim.submit(message)
+			}
+			ack := readOutputMessage(t, out)
+			if ack.ErrorWire == nil {
+				t.Fatalf("expected ErrorWire:\n%s", out)
+			}
+			if ack.ErrorWire.ErrorCategory != tc.category || ack.ErrorWire.ErrorCode != tc.code {
+				t.Errorf("unexpected ErrorWire: %#v", ack.ErrorWire)
+			}
+			if err := ack.ErrorWire.Validate(); err != nil {
+				t.Error(err)
+			}
+			if ack.MessageDisposition.MessageStatusIndicator != wire.MessageStatusRejected {
+				t.Errorf("MessageStatusIndicator=%q", ack.MessageDisposition.MessageStatusIndicator)
+			}
+			if ack.OutputMessageAccountabilityData == nil {
+				t.Error("expected OMAD")
+			}
+			if n := len(receiver.messages["231380104"]); n != len(tc.messages)-1 {
+				t.Errorf("forwarded %d messages", n)
+			}
+		})
+	}
+}
+
+func TestSimulator__schedule(t *testing.T) {
+	schedule := wire.NewSchedule()
+	sim, _ := newTestSimulator(failures{schedule: schedule})
+
+	// 6:30 p.m. ET is after the cutoff of customer transfers
+	sim.now = func() time.Time { return time.Date(2019, time.April, 10, 18, 30, 0, 0, schedule.Location) }
+	ack := readOutputMessage(t, sim.submit(mockMessage(t)))
+	if ack.ErrorWire == nil || ack.ErrorWire.ErrorCategory != wire.ErrorCategoryCutoffHour {
+		t.Errorf("unexpected ErrorWire: %#v", ack.ErrorWire)
+	}
+
+	// a bank transfer is accepted until 7:00 p.m. ET
+	ack = readOutputMessage(t, sim.submit(mockMessage(t, "Source08000001", "Source08000002", "{3600}CTR   ", "{3600}BTR   ")))
+	if ack.ErrorWire != nil && ack.ErrorWire.ErrorCategory == wire.ErrorCategoryCutoffHour {
+		t.Errorf("unexpected ErrorWire: %#v", ack.ErrorWire)
+	}
+}
+
+// TestSimulator__retryRejected validates the IMAD of a rejected message may be sent again
+func TestSimulator__retryRejected(t *testing.T) {
+	schedule := wire.NewSchedule()
+	sim, receiver := newTestSimulator(failures{schedule: schedule, balance: 1000000})
+
+	// rejected after the cutoff, then for insufficient balance
+	sim.now = func() time.Time { return time.Date(2019, time.April, 10, 18, 30, 0, 0, schedule.Location) }
+	ack := readOutputMessage(t, sim.submit(mockMessage(t)))
+	if ack.ErrorWire == nil || ack.ErrorWire.ErrorCategory != wire.ErrorCategoryCutoffHour {
+		t.Fatalf("unexpected ErrorWire: %#v", ack.ErrorWire)
+	}
+	sim.now = func() time.Time { return time.Date(2019, time.April, 10, 12, 30, 0, 0, schedule.Location) }
+	ack = readOutputMessage(t, sim.submit(mockMessage(t)))
+	if ack.ErrorWire == nil || ack.ErrorWire.ErrorCategory != wire.ErrorCategoryInsufficientBalance {
+		t.Fatalf("unexpected ErrorWire: %#v", ack.ErrorWire)
+	}
+
+	// accepted once corrected, after which the IMAD is a duplicate
+	sim.failures.balance = 0
+	ack = readOutputMessage(t, sim.submit(mockMessage(t)))
+	if ack.ErrorWire != nil {
+		t.Fatalf("unexpected ErrorWire: %#v", ack.ErrorWire)
+	}
+	ack = readOutputMessage(t, sim.submit(mockMessage(t)))
+	if ack.ErrorWire == nil || ack.ErrorWire.ErrorCategory != wire.ErrorCategoryDuplicateIMAD {
+		t.Errorf("unexpected ErrorWire: %#v", ack.ErrorWire)
+	}
+	if n := len(receiver.messages["231380104"]); n != 1 {
+		t.Errorf("forwarded %d messages", n)
+	}
+}
+
+func TestNewErrorWire(t *testing.T) {
+	ew := newErrorWire(wire.ErrorCategoryDataError, errorCodeInvalid, &wire.FieldError{FieldName: "{2000}", Value: "12,34", Err: wire.ErrNonNumeric})
+	if err := ew.Validate(); err != nil {
+		t.Error(err)
+	}
+	if n := len(ew.ErrorDescription); n > 35 {
+		t.Errorf("ErrorDescription is %d characters", n)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-kit/kit/log"
+	"github.com/moov-io/wire"
+)
+
+// serveSocket accepts connections on ln until it is closed. A connection submits FAIM text, ending each batch of
+// FEDWireMessages with an empty line or by closing its side of the connection. The acknowledgement of each
+// FEDWireMessage in the batch is written back, followed by an empty line.
+func serveSocket(logger log.Logger, ln net.Listener, sim *simulator) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go handleConn(logger, conn, sim)
+	}
+}
+
+func handleConn(logger log.Logger, conn net.Conn, sim *simulator) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	w := bufio.NewWriter(conn)
+	var batch []string
+	flush := func() bool {
+		for _, message := range splitMessages(batch) {
+			w.WriteString(sim.submit(message))
+		}
+		batch = nil
+		w.WriteString("\n")
+		if err := w.Flush(); err != nil {
+			logger.Log("socket", fmt.Sprintf("problem writing to %s: %v", conn.RemoteAddr(), err))
+			return false
+		}
+		return true
+	}
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line != "" {
+			batch = append(batch, line)
+			continue
+		}
+		if len(batch) > 0 && !flush() {
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		logger.Log("socket", fmt.Sprintf("problem reading from %s: %v", conn.RemoteAddr(), err))
+		return
+	}
+	if len(batch) > 0 {
+		flush()
+	}
+}
+
+// splitMessages splits lines of FAIM text into the FAIM text of each FEDWireMessage, each of which begins with a
+// SenderSupplied {1500} tag
+func splitMessages(lines []string) []string {
+	var messages []string
+	var buf strings.Builder
+	for _, line := range lines {
+		line = strings.TrimRight(line, "\r")
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, wire.TagSenderSupplied) && buf.Len() > 0 {
+			messages = append(messages, buf.String())
+			buf.Reset()
+		}
+		buf.WriteString(line + "\n")
+	}
+	if buf.Len() > 0 {
+		messages = append(messages, buf.String())
+	}
+	return messages
+}
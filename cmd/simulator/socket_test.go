@@ -0,0 +1,85 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/moov-io/wire"
+)
+
+func TestSocket(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	sim, receiver := newTestSimulator(failures{})
+	go serveSocket(log.NewNopLogger(), ln, sim)
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	readBatch := func() *wire.File {
+		var lines []string
+		for scanner.Scan() && scanner.Text() != "" {
+			lines = append(lines, scanner.Text())
+		}
+		file, err := wire.NewReader(strings.NewReader(strings.Join(lines, "\n"))).Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &file
+	}
+
+	// two FEDWireMessages in one batch
+	if _, err := conn.Write([]byte(mockMessage(t) + mockMessage(t, "Source08000001", "Source08000002") + "\n")); err != nil {
+		t.Fatal(err)
+	}
+	file := readBatch()
+	if n := len(file.FEDWireMessages); n != 2 {
+		t.Fatalf("read %d acknowledgements", n)
+	}
+	for i := range file.FEDWireMessages {
+		if ew := file.FEDWireMessages[i].ErrorWire; ew != nil {
+			t.Errorf("unexpected ErrorWire: %#v", ew)
+		}
+	}
+
+	// the connection stays open for the next batch
+	if _, err := conn.Write([]byte(mockMessage(t) + "\n")); err != nil {
+		t.Fatal(err)
+	}
+	file = readBatch()
+	if n := len(file.FEDWireMessages); n != 1 {
+		t.Fatalf("read %d acknowledgements", n)
+	}
+	if ew := file.FEDWireMessages[0].ErrorWire; ew == nil || ew.ErrorCategory != wire.ErrorCategoryDuplicateIMAD {
+		t.Errorf("unexpected ErrorWire: %#v", ew)
+	}
+	if n := len(receiver.messages["231380104"]); n != 2 {
+		t.Errorf("forwarded %d messages", n)
+	}
+}
+
+func TestSplitMessages(t *testing.T) {
+	lines := strings.Split(mockMessage(t)+"\r\n"+mockMessage(t), "\n")
+	messages := splitMessages(lines)
+	if len(messages) != 2 {
+		t.Fatalf("split %d messages", len(messages))
+	}
+	for i := range messages {
+		if messages[i] != mockMessage(t) {
+			t.Errorf("unexpected message:\n%s", messages[i])
+		}
+	}
+}
@@ -46,7 +46,8 @@ func jsonWrapper() js.Func {
 
 		parsed, err := parseContents(inputJSON)
 		if err != nil {
-			msg := "unable to parse wire file"
+			// each problem is shown with its line and carets under the characters with the problem
+			msg := "unable to parse wire file\n\n" + wire.RenderError(err)
 			fmt.Print(msg)
 			return msg
 		}
@@ -0,0 +1,135 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/moov-io/wire"
+)
+
+// Formats a File is converted to
+const (
+	formatJSON = "json"
+	formatFAIM = "faim"
+)
+
+// convertFile converts a File between FAIM text and JSON, to the format the file is not in unless -to is given
+func convertFile(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("convert", "[file]", stderr)
+	var opts readOptions
+	opts.register(fs)
+	to := fs.String("to", "", "Format to convert to, json or faim. Defaults to the format the file is not in")
+	output := fs.String("o", "", "File to write to, stdout when empty")
+	variableLength := fs.Bool("variable-length", false, "Write FAIM tags in the delimited variable length format")
+	stripOutputTags := fs.Bool("strip-output-tags", false, "Skip the tags appended by the Fedwire Funds Service when writing FAIM text")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	path, ok := singlePath(fs, stderr)
+	if !ok {
+		return exitUsage
+	}
+
+	bs, err := readInput(path, stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", path, err)
+		return exitUsage
+	}
+	format := *to
+	if format == "" {
+		format = formatJSON
+		if isJSON(bs) {
+			format = formatFAIM
+		}
+	}
+	if format != formatJSON && format != formatFAIM {
+		fmt.Fprintf(stderr, "unknown format %q, use json or faim\n", format)
+		return exitUsage
+	}
+	file, err := readFile(bs, opts)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: could not be read\n%s", path, wire.RenderError(err))
+		return exitInvalid
+	}
+
+	var out []byte
+	if format == formatJSON {
+		out, err = json.MarshalIndent(file, "", "  ")
+		out = append(out, '\n')
+	} else {
+		out, err = writeFAIM(file, *variableLength, *stripOutputTags)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", path, err)
+		return exitInvalid
+	}
+	if err := writeOutput(*output, out, stdout); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+	return exitOK
+}
+
+// formatFile rewrites a File as FAIM text, with each tag space filled to its full width unless -variable-length
+// is given, so files differing only in their padding or delimiters are written the same.
+func formatFile(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("format", "[file]", stderr)
+	var opts readOptions
+	opts.register(fs)
+	write := fs.Bool("w", false, "Write the result to the file rather than stdout")
+	variableLength := fs.Bool("variable-length", false, "Write tags in the delimited variable length format")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	path, ok := singlePath(fs, stderr)
+	if !ok {
+		return exitUsage
+	}
+	if *write && path == "-" {
+		fmt.Fprintln(stderr, "-w requires a file")
+		return exitUsage
+	}
+
+	bs, err := readInput(path, stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", path, err)
+		return exitUsage
+	}
+	file, err := readFile(bs, opts)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: could not be read\n%s", path, wire.RenderError(err))
+		return exitInvalid
+	}
+	out, err := writeFAIM(file, *variableLength, false)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", path, err)
+		return exitInvalid
+	}
+	output := ""
+	if *write {
+		output = path
+	}
+	if err := writeOutput(output, out, stdout); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+	return exitOK
+}
+
+// singlePath returns the file argument of a command which reads one file, - when none is given
+func singlePath(fs *flag.FlagSet, stderr io.Writer) (string, bool) {
+	switch fs.NArg() {
+	case 0:
+		return "-", true
+	case 1:
+		return fs.Arg(0), true
+	}
+	fs.Usage()
+	return "", false
+}
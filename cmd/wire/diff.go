@@ -0,0 +1,62 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/moov-io/wire"
+)
+
+// diffFiles prints the differences between the FEDWireMessages of two files, exiting with exitInvalid when they
+// differ and exitUsage when either can not be read, as diff does.
+func diffFiles(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("diff", "file1 file2", stderr)
+	var opts readOptions
+	opts.register(fs)
+	asJSON := fs.Bool("json", false, "Print the differences as JSON")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	files := make([]*wire.File, 2)
+	for i, path := range fs.Args() {
+		bs, err := readInput(path, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", path, err)
+			return exitUsage
+		}
+		files[i], err = readFile(bs, opts)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: could not be read\n%s", path, wire.RenderError(err))
+			return exitUsage
+		}
+	}
+
+	diffs := wire.DiffFiles(files[0], files[1])
+	if *asJSON {
+		if diffs == nil {
+			diffs = wire.Differences{}
+		}
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(diffs); err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitUsage
+		}
+	} else {
+		fmt.Fprint(stdout, diffs.String())
+	}
+	if len(diffs) > 0 {
+		return exitInvalid
+	}
+	return exitOK
+}
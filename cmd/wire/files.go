@@ -0,0 +1,83 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"io/ioutil"
+
+	"github.com/moov-io/wire"
+)
+
+// readOptions are the flags controlling how FAIM text is read
+type readOptions struct {
+	strict      bool
+	unknownTags bool
+}
+
+func (o *readOptions) register(fs *flag.FlagSet) {
+	fs.BoolVar(&o.strict, "strict", false, "Fail on a tag of a FEDWireMessage out of the FAIM order or repeated")
+	fs.BoolVar(&o.unknownTags, "unknown-tags", false, "Keep tags which are not recognised, rather than failing to read them")
+}
+
+func (o readOptions) readerOptions() []wire.ReaderOptionFunc {
+	return []wire.ReaderOptionFunc{
+		wire.StrictTags(o.strict),
+		wire.PreserveUnknownTags(o.unknownTags),
+	}
+}
+
+// readInput returns the contents of the file at path, or of stdin when path is -
+func readInput(path string, stdin io.Reader) ([]byte, error) {
+	if path == "-" {
+		return ioutil.ReadAll(stdin)
+	}
+	return ioutil.ReadFile(path)
+}
+
+// isJSON returns true when bs holds JSON rather than FAIM text
+func isJSON(bs []byte) bool {
+	return json.Valid(bs)
+}
+
+// readFile reads the File of bs, which is either JSON or FAIM text. The File read so far is returned along with
+// any errors reading FAIM text.
+func readFile(bs []byte, opts readOptions) (*wire.File, error) {
+	if isJSON(bs) {
+		file, err := wire.FileFromJSON(bs)
+		if err != nil {
+			return nil, err
+		}
+		if file == nil {
+			return nil, errors.New("no JSON File")
+		}
+		return file, nil
+	}
+	file, err := wire.NewReader(bytes.NewReader(bs), opts.readerOptions()...).Read()
+	return &file, err
+}
+
+// writeFAIM returns the FAIM text of file, in the delimited variable length format when variableLength is true
+func writeFAIM(file *wire.File, variableLength, stripOutputTags bool) ([]byte, error) {
+	var buf bytes.Buffer
+	w := wire.NewWriter(&buf, wire.VariableLengthFields(variableLength), wire.StripOutputTags(stripOutputTags))
+	if err := w.Write(file); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeOutput writes data to the file at path, or to stdout when path is empty
+func writeOutput(path string, data []byte, stdout io.Writer) error {
+	if path == "" {
+		_, err := stdout.Write(data)
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// wire reads, validates and converts wire files from the command line, without running the HTTP server. Files
+// are read as FAIM text, or as JSON when they hold a JSON File, from the paths given or stdin when the path is -
+// or none is given.
+//
+//	wire validate [flags] file...
+//	wire convert [flags] [file]
+//	wire print [flags] [file]
+//	wire format [flags] [file]
+//	wire diff [flags] file1 file2
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/moov-io/wire"
+)
+
+// Exit codes, so scripts and CI can tell an invalid file from a misused command
+const (
+	exitOK      = 0
+	exitInvalid = 1 // a file could not be read as a wire file or is not valid, or the files diffed differ
+	exitUsage   = 2 // the command was misused or a file could not be opened or written
+)
+
+// command is a subcommand of wire
+type command struct {
+	name        string
+	description string
+	run         func(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+}
+
+var commands = []command{
+	{"validate", "Validate wire files, exiting with 1 when any is not valid", validateFiles},
+	{"convert", "Convert a wire file between FAIM text and JSON", convertFile},
+	{"print", "Print the tags of a wire file with their names", printFile},
+	{"format", "Rewrite a wire file as FAIM text with normalised padding", formatFile},
+	{"diff", "Print the differences between the messages of two wire files, exiting with 1 when they differ", diffFiles},
+	{"version", "Print the version of wire", printVersion},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(args[1:], stdin, stdout, stderr)
+		}
+	}
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		usage(stdout)
+		return exitOK
+	}
+	fmt.Fprintf(stderr, "wire: unknown command %q\n\n", args[0])
+	usage(stderr)
+	return exitUsage
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: wire <command> [flags] [file...]\n\nCommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", c.name, c.description)
+	}
+	fmt.Fprintf(w, "\nFiles are read from stdin when the path is - or none is given. Run wire <command> -h for the flags of a command.\n")
+}
+
+// newFlagSet returns the FlagSet of the command name, which writes its usage to stderr
+func newFlagSet(name, arguments string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: wire %s [flags] %s\n\nFlags:\n", name, arguments)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+func printVersion(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fmt.Fprintln(stdout, wire.Version)
+	return exitOK
+}
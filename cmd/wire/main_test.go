@@ -0,0 +1,150 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var customerTransferPath = filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt")
+
+// runCommand runs wire with args and stdin, returning the exit code along with stdout and stderr
+func runCommand(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun__usage(t *testing.T) {
+	if code, _, stderr := runCommand("", "bogus"); code != exitUsage || !strings.Contains(stderr, "unknown command") {
+		t.Errorf("code=%d stderr=%s", code, stderr)
+	}
+	if code, _, _ := runCommand(""); code != exitUsage {
+		t.Errorf("code=%d", code)
+	}
+	if code, stdout, _ := runCommand("", "help"); code != exitOK || !strings.Contains(stdout, "validate") {
+		t.Errorf("code=%d stdout=%s", code, stdout)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	code, stdout, _ := runCommand("", "validate", customerTransferPath)
+	if code != exitOK || !strings.HasSuffix(stdout, "valid\n") {
+		t.Errorf("code=%d stdout=%s", code, stdout)
+	}
+
+	bs, err := ioutil.ReadFile(customerTransferPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid := strings.Replace(string(bs), "{2000}000001234567", "{2000}00000123456Z", 1)
+	code, stdout, _ = runCommand(invalid, "validate", "-quiet")
+	if code != exitInvalid {
+		t.Errorf("code=%d", code)
+	}
+	if !strings.Contains(stdout, "{2000}00000123456Z\n      ^^^^^^^^^^^^") {
+		t.Errorf("unexpected output:\n%s", stdout)
+	}
+
+	if code, _, _ := runCommand("", "validate", filepath.Join("testdata", "missing.txt")); code != exitUsage {
+		t.Errorf("code=%d", code)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	code, asJSON, stderr := runCommand("", "convert", customerTransferPath)
+	if code != exitOK {
+		t.Fatalf("code=%d stderr=%s", code, stderr)
+	}
+	if !strings.Contains(asJSON, `"senderSupplied": {`) {
+		t.Errorf("unexpected JSON:\n%s", asJSON)
+	}
+
+	code, faim, stderr := runCommand(asJSON, "convert")
+	if code != exitOK {
+		t.Fatalf("code=%d stderr=%s", code, stderr)
+	}
+	_, formatted, _ := runCommand("", "format", customerTransferPath)
+	if faim != formatted {
+		t.Errorf("JSON converted to FAIM text differs from the file:\n%s\n%s", faim, formatted)
+	}
+
+	if code, _, _ := runCommand("", "convert", "-to", "xml", customerTransferPath); code != exitUsage {
+		t.Errorf("code=%d", code)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	_, fixed, _ := runCommand("", "format", customerTransferPath)
+
+	code, delimited, stderr := runCommand(fixed, "format", "-variable-length")
+	if code != exitOK {
+		t.Fatalf("code=%d stderr=%s", code, stderr)
+	}
+	if !strings.Contains(delimited, "{3100}121042882Wells Fargo NA*\n") {
+		t.Errorf("unexpected delimited output:\n%s", delimited)
+	}
+
+	dir, err := ioutil.TempDir("", "wire-format")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "message.txt")
+	if err := ioutil.WriteFile(path, []byte(delimited), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if code, _, stderr := runCommand("", "format", "-w", path); code != exitOK {
+		t.Fatalf("code=%d stderr=%s", code, stderr)
+	}
+	if bs, _ := ioutil.ReadFile(path); string(bs) != fixed {
+		t.Errorf("padding was not normalised:\n%s", bs)
+	}
+}
+
+func TestPrint(t *testing.T) {
+	code, stdout, stderr := runCommand("", "print", customerTransferPath)
+	if code != exitOK {
+		t.Fatalf("code=%d stderr=%s", code, stderr)
+	}
+	if !strings.Contains(stdout, "{3100}  SenderDepositoryInstitution     121042882Wells Fargo NA\n") {
+		t.Errorf("unexpected output:\n%s", stdout)
+	}
+
+	code, stdout, _ = runCommand("{1500}30User ReqT \n{9999}Proprietary\n", "print")
+	if code != exitInvalid || !strings.Contains(stdout, "{9999}  Unknown") {
+		t.Errorf("code=%d stdout=%s", code, stdout)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	if code, stdout, stderr := runCommand("", "diff", customerTransferPath, customerTransferPath); code != exitOK || stdout != "" {
+		t.Errorf("code=%d stdout=%s stderr=%s", code, stdout, stderr)
+	}
+
+	bs, err := ioutil.ReadFile(customerTransferPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed := strings.Replace(string(bs), "{2000}000001234567", "{2000}000001234568", 1)
+	code, stdout, _ := runCommand(changed, "diff", customerTransferPath, "-")
+	if code != exitInvalid || stdout != "~ {2000} amount.amount: \"000001234567\" -> \"000001234568\"\n" {
+		t.Errorf("code=%d stdout=%s", code, stdout)
+	}
+
+	code, stdout, _ = runCommand(changed, "diff", "-json", customerTransferPath, "-")
+	if code != exitInvalid || !strings.Contains(stdout, `"change": "changed"`) {
+		t.Errorf("code=%d stdout=%s", code, stdout)
+	}
+
+	if code, _, _ := runCommand("", "diff", customerTransferPath); code != exitUsage {
+		t.Errorf("code=%d", code)
+	}
+}
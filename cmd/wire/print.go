@@ -0,0 +1,82 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/moov-io/wire"
+)
+
+// printFile prints each tag of a File on its own line along with the name of the tag, each FEDWireMessage
+// separated by a blank line. Problems reading the File are printed after its tags.
+func printFile(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("print", "[file]", stderr)
+	var opts readOptions
+	opts.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	path, ok := singlePath(fs, stderr)
+	if !ok {
+		return exitUsage
+	}
+
+	bs, err := readInput(path, stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", path, err)
+		return exitUsage
+	}
+	file, readErr := readFile(bs, opts)
+	if isJSON(bs) {
+		if readErr == nil {
+			bs, readErr = writeFAIM(file, false, false)
+		}
+		if readErr != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", path, readErr)
+			return exitInvalid
+		}
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	outputTag := false
+	for i, line := range strings.Split(strings.TrimRight(string(bs), "\r\n"), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if len(line) < 6 {
+			fmt.Fprintf(tw, "%s\n", line)
+			continue
+		}
+		tag := line[:6]
+		// each SenderSupplied tag begins a new FEDWireMessage, as do the tags appended ahead of it
+		if i > 0 && !outputTag && (tag == wire.TagSenderSupplied || isOutputTag(tag)) {
+			fmt.Fprintln(tw)
+		}
+		outputTag = isOutputTag(tag)
+		name := wire.TagName(tag)
+		if name == "" {
+			name = "Unknown"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", tag, name, strings.TrimRight(line[6:], " "))
+	}
+	tw.Flush()
+
+	if readErr != nil {
+		fmt.Fprintf(stderr, "\n%s: could not be read\n%s", path, wire.RenderError(readErr))
+		return exitInvalid
+	}
+	return exitOK
+}
+
+// isOutputTag returns true when tag is one of the tags the Fedwire Funds Service appends to an output message
+func isOutputTag(tag string) bool {
+	switch tag {
+	case wire.TagMessageDisposition, wire.TagReceiptTimeStamp, wire.TagOutputMessageAccountabilityData, wire.TagErrorWire:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/moov-io/wire"
+)
+
+// validateFiles reads and validates each file, printing every problem found. It exits with exitInvalid when
+// any file could not be read as a wire file or is not valid.
+func validateFiles(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := newFlagSet("validate", "[file...]", stderr)
+	var opts readOptions
+	opts.register(fs)
+	quiet := fs.Bool("quiet", false, "Only print problems, not the files which are valid")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	code := exitOK
+	for _, path := range paths {
+		bs, err := readInput(path, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", path, err)
+			code = exitUsage
+			continue
+		}
+		file, err := readFile(bs, opts)
+		if err != nil {
+			fmt.Fprintf(stdout, "%s: could not be read\n%s", path, wire.RenderError(err))
+			if code == exitOK {
+				code = exitInvalid
+			}
+			continue
+		}
+		if errs := file.ValidateAll(); len(errs) > 0 {
+			fmt.Fprintf(stdout, "%s: is not valid\n", path)
+			for i := range errs {
+				fmt.Fprintf(stdout, "message %d: %v\n", errs[i].MessageIndex+1, errs[i])
+			}
+			if code == exitOK {
+				code = exitInvalid
+			}
+			continue
+		}
+		if !*quiet {
+			fmt.Fprintf(stdout, "%s: valid\n", path)
+		}
+	}
+	return code
+}
@@ -0,0 +1,171 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// ColumnError locates an error reading a tag within the line it was read from. The Reader returns it as the Err
+// of a base.ParseError.
+type ColumnError struct {
+	// Field is the name of the field with the problem, "tag" for the tag itself, or empty when the problem is
+	// not with a single field
+	Field string `json:"field,omitempty"`
+	// Start is the offset of the first character with the problem, from 0
+	Start int `json:"start"`
+	// End is the offset after the last character with the problem. End equals Start when characters are
+	// missing at Start.
+	End int `json:"end"`
+	// Text is the line as read
+	Text string `json:"text"`
+	// Err is the problem found
+	Err error `json:"-"`
+}
+
+func (e *ColumnError) Error() string {
+	columns := fmt.Sprintf("column %d", e.Start+1)
+	if e.End-e.Start > 1 {
+		columns = fmt.Sprintf("columns %d-%d", e.Start+1, e.End)
+	}
+	// a FieldError begins with its field name
+	if _, ok := e.Err.(*FieldError); ok || e.Field == "" {
+		return fmt.Sprintf("%v at %s", e.Err, columns)
+	}
+	return fmt.Sprintf("%v at %s of %s", e.Err, columns, e.Field)
+}
+
+// Unwrap implements the base.UnwrappableError interface for ColumnError
+func (e *ColumnError) Unwrap() error {
+	return e.Err
+}
+
+// Snippet returns the line the error was found in, followed by a line marking the characters with the problem
+// with carets.
+func (e *ColumnError) Snippet() string {
+	width := e.End - e.Start
+	if width < 1 {
+		width = 1
+	}
+	return e.Text + "\n" + strings.Repeat(" ", e.Start) + strings.Repeat("^", width)
+}
+
+// span is the offset range of a field within a line
+type span struct {
+	Name  string
+	Start int
+	End   int
+}
+
+// fieldSpans returns the offset range of the tag and each of its elements within record, which may be in the
+// fixed width or delimited format. The ranges of a fixed width record are those of a record of the expected
+// length, even when record is shorter or longer.
+func fieldSpans(record string) []span {
+	if len(record) < 6 {
+		return nil
+	}
+	spans := []span{{Name: "tag", Start: 0, End: 6}}
+	delimited := isDelimited(record)
+	offset := 6
+	for _, e := range tagElements[record[:6]] {
+		end := offset + e.Length
+		if delimited {
+			if offset > len(record) {
+				offset = len(record)
+			}
+			end = offset + e.Length
+			if end > len(record) {
+				end = len(record)
+			}
+			if e.Variable {
+				limit := offset + e.Length + 1
+				if limit > len(record) {
+					limit = len(record)
+				}
+				if idx := strings.Index(record[offset:limit], Delimiter); idx >= 0 {
+					spans = append(spans, span{Name: e.Name, Start: offset, End: offset + idx})
+					offset += idx + 1
+					continue
+				}
+			}
+		}
+		spans = append(spans, span{Name: e.Name, Start: offset, End: end})
+		offset = end
+	}
+	return spans
+}
+
+// newColumnError returns a ColumnError locating err within text, the line of a tag
+func newColumnError(text string, err error) *ColumnError {
+	ce := &ColumnError{
+		End:  len(text),
+		Text: text,
+		Err:  err,
+	}
+	spans := fieldSpans(text)
+	switch e := err.(type) {
+	case *FieldError:
+		ce.Field = e.FieldName
+		if len(spans) > 0 {
+			ce.Start = spans[0].End
+		}
+		for _, s := range spans {
+			if s.Name == e.FieldName {
+				ce.Start, ce.End = s.Start, s.End
+				break
+			}
+		}
+	case TagWrongLengthErr:
+		ce.Start, ce.End = e.Length, e.TagLength
+		if e.Length > e.TagLength {
+			ce.Start, ce.End = e.TagLength, e.Length
+		}
+		for _, s := range spans {
+			if s.Start <= ce.Start && ce.Start < s.End {
+				ce.Field = s.Name
+				break
+			}
+		}
+	case ErrInvalidTag, ErrDuplicateTag, ErrTagOutOfOrder:
+		ce.Field = "tag"
+		if ce.End > 6 {
+			ce.End = 6
+		}
+	}
+	return ce
+}
+
+// RenderError returns a human readable description of err, a base.ErrorList or base.ParseError returned by the
+// Reader, printing the line of each error found with a ColumnError with carets under the characters with the
+// problem.
+func RenderError(err error) string {
+	var buf strings.Builder
+	el, ok := err.(base.ErrorList)
+	if !ok {
+		el = base.ErrorList{err}
+	}
+	for i := range el {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		pe, ok := el[i].(*base.ParseError)
+		if !ok {
+			buf.WriteString(el[i].Error() + "\n")
+			continue
+		}
+		buf.WriteString(fmt.Sprintf("line %d", pe.Line))
+		if pe.Record != "" {
+			buf.WriteString(" " + pe.Record)
+		}
+		buf.WriteString(": " + pe.Err.Error() + "\n")
+		if ce, ok := pe.Err.(*ColumnError); ok {
+			buf.WriteString(ce.Snippet() + "\n")
+		}
+	}
+	return buf.String()
+}
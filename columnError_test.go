@@ -0,0 +1,79 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+)
+
+// readColumnError returns the ColumnError of the first error reading input
+func readColumnError(t *testing.T, input string, opts ...ReaderOptionFunc) (*base.ParseError, *ColumnError) {
+	t.Helper()
+	_, err := NewReader(strings.NewReader(input), opts...).Read()
+	el, ok := err.(base.ErrorList)
+	if !ok || len(el) == 0 {
+		t.Fatalf("%T: %v", err, err)
+	}
+	pe, ok := el[0].(*base.ParseError)
+	if !ok {
+		t.Fatalf("%T: %v", el[0], el[0])
+	}
+	ce, ok := pe.Err.(*ColumnError)
+	if !ok {
+		t.Fatalf("%T: %v", pe.Err, pe.Err)
+	}
+	return pe, ce
+}
+
+func TestColumnError(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		line       string
+		field      string
+		start, end int
+	}{
+		{"field", "{2000}00000123456Z", "Amount", 6, 18},
+		{"short", "{3100}121042882Wells Fargo", "SenderShortName", 26, 33},
+		{"long", "{2000}000001234567890", "", 18, 21},
+		{"tag", "{3100}121042882Wells Fargo NA    " + "\n" + "{3100}121042882Wells Fargo NA    ", "tag", 0, 6},
+		{"invalid tag", "{0000}Unknown", "tag", 0, 6},
+		{"fixed", "{4200}D123456789" + strings.Repeat(" ", 25) + "Na`me" + strings.Repeat(" ", 135), "Name", 41, 76},
+		{"delimited", "{4200}D123456789*Na`me*", "Name", 17, 22},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ce := readColumnError(t, tc.line, StrictTags(true))
+			if ce.Field != tc.field || ce.Start != tc.start || ce.End != tc.end {
+				t.Errorf("field=%q start=%d end=%d: %v", ce.Field, ce.Start, ce.End, ce)
+			}
+		})
+	}
+}
+
+func TestColumnError__Error(t *testing.T) {
+	pe, ce := readColumnError(t, "{3100}121042882Wells Fargo")
+	if pe.Line != 1 || pe.Record != "SenderDepositoryInstitution" {
+		t.Errorf("line=%d record=%q", pe.Line, pe.Record)
+	}
+	if got := ce.Error(); got != "must be 33 characters and found 26 at columns 27-33 of SenderShortName" {
+		t.Errorf("unexpected error: %s", got)
+	}
+	if !base.Match(pe, TagWrongLengthErr{}) {
+		t.Errorf("%T does not match TagWrongLengthErr", pe.Err)
+	}
+}
+
+func TestRenderError(t *testing.T) {
+	_, err := NewReader(strings.NewReader("{1500}30User ReqT \n{2000}00000123456Z")).Read()
+	expected := `line 2 Amount: Amount 00000123456Z is an incorrect amount format at columns 7-18
+{2000}00000123456Z
+      ^^^^^^^^^^^^
+`
+	if got := RenderError(err); got != expected {
+		t.Errorf("unexpected rendering:\n%s", got)
+	}
+}
@@ -146,6 +146,38 @@ const (
 	MessageDuplicationOriginal = ""
 	// MessageDuplicationResend designates a resend of a message
 	MessageDuplicationResend = "P"
+	// MessageDuplicationRetrieval designates MessageDisposition {1100} of a retrieval of an original message
+	MessageDuplicationRetrieval = "R"
+
+	// MessageStatusIndicator of MessageDisposition {1100}
+
+	// MessageStatusInProcess is an outgoing message in process or intercepted
+	MessageStatusInProcess = "0"
+	// MessageStatusSuccessfulValue is an outgoing message successful with accounting (value)
+	MessageStatusSuccessfulValue = "2"
+	// MessageStatusRejected is an outgoing message rejected due to an error condition
+	MessageStatusRejected = "3"
+	// MessageStatusSuccessfulNonValue is an outgoing message successful without accounting (non-value)
+	MessageStatusSuccessfulNonValue = "7"
+	// MessageStatusIncomingValue is an incoming message successful with accounting (value)
+	MessageStatusIncomingValue = "N"
+	// MessageStatusIncomingNonValue is an incoming message successful without accounting (non-value)
+	MessageStatusIncomingNonValue = "S"
+
+	// ErrorCategory of ErrorWire {1130}
+
+	// ErrorCategoryDataError is a data error
+	ErrorCategoryDataError = "E"
+	// ErrorCategoryInsufficientBalance is an insufficient balance
+	ErrorCategoryInsufficientBalance = "F"
+	// ErrorCategoryAccountabilityError is an accountability error
+	ErrorCategoryAccountabilityError = "H"
+	// ErrorCategoryInProcess is a message in process or intercepted
+	ErrorCategoryInProcess = "I"
+	// ErrorCategoryCutoffHour is a message received after its cutoff hour
+	ErrorCategoryCutoffHour = "W"
+	// ErrorCategoryDuplicateIMAD is a message with a duplicate IMAD
+	ErrorCategoryDuplicateIMAD = "X"
 
 	// TypeCode
 
@@ -33,6 +33,11 @@ func NewCurrencyInstructedAmount() *CurrencyInstructedAmount {
 	return cia
 }
 
+// setTag sets the tag of CurrencyInstructedAmount, which JSON does not hold
+func (cia *CurrencyInstructedAmount) setTag() {
+	cia.tag = TagCurrencyInstructedAmount
+}
+
 // Parse takes the input string and parses the CurrencyInstructedAmount values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -0,0 +1,41 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// CustomerTransferBuilder builds a customer transfer (CTR) FEDWireMessage and only sets the tags it may have. The
+// TypeSubType defaults to a basic funds transfer (1000). A customer transfer requires a Beneficiary, and an
+// Originator or OriginatorFI.
+type CustomerTransferBuilder struct {
+	messageBuilder
+}
+
+// NewCustomerTransferBuilder returns a new CustomerTransferBuilder
+func NewCustomerTransferBuilder() *CustomerTransferBuilder {
+	return &CustomerTransferBuilder{newMessageBuilder(CustomerTransfer, builderTypeSubType(CustomerTransferFundsTransfer))}
+}
+
+// CustomerTransferTypeSubType is a TypeSubType {1510} a customer transfer may have
+type CustomerTransferTypeSubType builderTypeSubType
+
+var (
+	// CustomerTransferFundsTransfer is a basic funds transfer (1000)
+	CustomerTransferFundsTransfer = CustomerTransferTypeSubType{FundsTransfer, BasicFundsTransfer}
+	// CustomerTransferFundsReversal is a reversal of a funds transfer (1002)
+	CustomerTransferFundsReversal = CustomerTransferTypeSubType{FundsTransfer, ReversalTransfer}
+	// CustomerTransferFundsReversalPriorDay is a reversal of a prior day funds transfer (1008)
+	CustomerTransferFundsReversalPriorDay = CustomerTransferTypeSubType{FundsTransfer, ReversalPriorDayTransfer}
+	// CustomerTransferForeignTransfer is a basic foreign transfer (1500)
+	CustomerTransferForeignTransfer = CustomerTransferTypeSubType{ForeignTransfer, BasicFundsTransfer}
+	// CustomerTransferForeignReversal is a reversal of a foreign transfer (1502)
+	CustomerTransferForeignReversal = CustomerTransferTypeSubType{ForeignTransfer, ReversalTransfer}
+	// CustomerTransferForeignReversalPriorDay is a reversal of a prior day foreign transfer (1508)
+	CustomerTransferForeignReversalPriorDay = CustomerTransferTypeSubType{ForeignTransfer, ReversalPriorDayTransfer}
+	// CustomerTransferSettlementTransfer is a basic settlement transfer (1600)
+	CustomerTransferSettlementTransfer = CustomerTransferTypeSubType{SettlementTransfer, BasicFundsTransfer}
+	// CustomerTransferSettlementReversal is a reversal of a settlement transfer (1602)
+	CustomerTransferSettlementReversal = CustomerTransferTypeSubType{SettlementTransfer, ReversalTransfer}
+	// CustomerTransferSettlementReversalPriorDay is a reversal of a prior day settlement transfer (1608)
+	CustomerTransferSettlementReversalPriorDay = CustomerTransferTypeSubType{SettlementTransfer, ReversalPriorDayTransfer}
+)
@@ -0,0 +1,54 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// CustomerTransferPlusBuilder builds a customer transfer plus (CTP) FEDWireMessage and only sets the tags it may
+// have. The TypeSubType defaults to a basic funds transfer (1000). A customer transfer plus requires a Beneficiary,
+// an Originator and a LocalInstrument, which determines the cover payment, unstructured addenda and remittance tags
+// it requires.
+type CustomerTransferPlusBuilder struct {
+	messageBuilder
+}
+
+// NewCustomerTransferPlusBuilder returns a new CustomerTransferPlusBuilder
+func NewCustomerTransferPlusBuilder() *CustomerTransferPlusBuilder {
+	return &CustomerTransferPlusBuilder{newMessageBuilder(CustomerTransferPlus, builderTypeSubType(CustomerTransferPlusFundsTransfer))}
+}
+
+// CustomerTransferPlusTypeSubType is a TypeSubType {1510} a customer transfer plus may have
+type CustomerTransferPlusTypeSubType builderTypeSubType
+
+var (
+	// CustomerTransferPlusFundsTransfer is a basic funds transfer (1000)
+	CustomerTransferPlusFundsTransfer = CustomerTransferPlusTypeSubType{FundsTransfer, BasicFundsTransfer}
+	// CustomerTransferPlusFundsRequestReversal is a request for reversal of a funds transfer (1001)
+	CustomerTransferPlusFundsRequestReversal = CustomerTransferPlusTypeSubType{FundsTransfer, RequestReversal}
+	// CustomerTransferPlusFundsReversal is a reversal of a funds transfer (1002)
+	CustomerTransferPlusFundsReversal = CustomerTransferPlusTypeSubType{FundsTransfer, ReversalTransfer}
+	// CustomerTransferPlusFundsRequestReversalPriorDay is a request for reversal of a prior day funds transfer (1007)
+	CustomerTransferPlusFundsRequestReversalPriorDay = CustomerTransferPlusTypeSubType{FundsTransfer, RequestReversalPriorDayTransfer}
+	// CustomerTransferPlusFundsReversalPriorDay is a reversal of a prior day funds transfer (1008)
+	CustomerTransferPlusFundsReversalPriorDay = CustomerTransferPlusTypeSubType{FundsTransfer, ReversalPriorDayTransfer}
+	// CustomerTransferPlusForeignTransfer is a basic foreign transfer (1500)
+	CustomerTransferPlusForeignTransfer = CustomerTransferPlusTypeSubType{ForeignTransfer, BasicFundsTransfer}
+	// CustomerTransferPlusForeignRequestReversal is a request for reversal of a foreign transfer (1501)
+	CustomerTransferPlusForeignRequestReversal = CustomerTransferPlusTypeSubType{ForeignTransfer, RequestReversal}
+	// CustomerTransferPlusForeignReversal is a reversal of a foreign transfer (1502)
+	CustomerTransferPlusForeignReversal = CustomerTransferPlusTypeSubType{ForeignTransfer, ReversalTransfer}
+	// CustomerTransferPlusForeignRequestReversalPriorDay is a request for reversal of a prior day foreign transfer (1507)
+	CustomerTransferPlusForeignRequestReversalPriorDay = CustomerTransferPlusTypeSubType{ForeignTransfer, RequestReversalPriorDayTransfer}
+	// CustomerTransferPlusForeignReversalPriorDay is a reversal of a prior day foreign transfer (1508)
+	CustomerTransferPlusForeignReversalPriorDay = CustomerTransferPlusTypeSubType{ForeignTransfer, ReversalPriorDayTransfer}
+	// CustomerTransferPlusSettlementTransfer is a basic settlement transfer (1600)
+	CustomerTransferPlusSettlementTransfer = CustomerTransferPlusTypeSubType{SettlementTransfer, BasicFundsTransfer}
+	// CustomerTransferPlusSettlementRequestReversal is a request for reversal of a settlement transfer (1601)
+	CustomerTransferPlusSettlementRequestReversal = CustomerTransferPlusTypeSubType{SettlementTransfer, RequestReversal}
+	// CustomerTransferPlusSettlementReversal is a reversal of a settlement transfer (1602)
+	CustomerTransferPlusSettlementReversal = CustomerTransferPlusTypeSubType{SettlementTransfer, ReversalTransfer}
+	// CustomerTransferPlusSettlementRequestReversalPriorDay is a request for reversal of a prior day settlement transfer (1607)
+	CustomerTransferPlusSettlementRequestReversalPriorDay = CustomerTransferPlusTypeSubType{SettlementTransfer, RequestReversalPriorDayTransfer}
+	// CustomerTransferPlusSettlementReversalPriorDay is a reversal of a prior day settlement transfer (1608)
+	CustomerTransferPlusSettlementReversalPriorDay = CustomerTransferPlusTypeSubType{SettlementTransfer, ReversalPriorDayTransfer}
+)
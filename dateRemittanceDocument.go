@@ -30,6 +30,11 @@ func NewDateRemittanceDocument() *DateRemittanceDocument {
 	return drd
 }
 
+// setTag sets the tag of DateRemittanceDocument, which JSON does not hold
+func (drd *DateRemittanceDocument) setTag() {
+	drd.tag = TagDateRemittanceDocument
+}
+
 // Parse takes the input string and parses the DateRemittanceDocument values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -0,0 +1,443 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Delimiter terminates a variable length element when a tag is in the FAIM delimited format
+const Delimiter = "*"
+
+// element describes a single element of a tag in the order it appears in the fixed width format
+type element struct {
+	// Name is the field name of the element
+	Name string
+	// Length is the maximum length of the element
+	Length int
+	// Variable is true when the element may be shortened and terminated by a Delimiter
+	Variable bool
+}
+
+// fixed returns a fixed length element
+func fixed(name string, length int) element {
+	return element{Name: name, Length: length}
+}
+
+// variable returns a variable length element
+func variable(name string, length int) element {
+	return element{Name: name, Length: length, Variable: true}
+}
+
+var (
+	financialInstitutionElements = []element{
+		fixed("IdentificationCode", 1),
+		variable("Identifier", 34),
+		variable("Name", 35),
+		variable("AddressLineOne", 35),
+		variable("AddressLineTwo", 35),
+		variable("AddressLineThree", 35),
+	}
+	fiToFIElements = []element{
+		variable("LineOne", 30),
+		variable("LineTwo", 33),
+		variable("LineThree", 33),
+		variable("LineFour", 33),
+		variable("LineFive", 33),
+		variable("LineSix", 33),
+	}
+	adviceElements = []element{
+		fixed("AdviceCode", 3),
+		variable("LineOne", 26),
+		variable("LineTwo", 33),
+		variable("LineThree", 33),
+		variable("LineFour", 33),
+		variable("LineFive", 33),
+		variable("LineSix", 33),
+	}
+	coverPaymentElements = []element{
+		fixed("SwiftFieldTag", 5),
+		variable("SwiftLineOne", 35),
+		variable("SwiftLineTwo", 35),
+		variable("SwiftLineThree", 35),
+		variable("SwiftLineFour", 35),
+		variable("SwiftLineFive", 35),
+	}
+	remittanceAmountElements = []element{
+		fixed("CurrencyCode", 3),
+		variable("Amount", 19),
+	}
+	remittanceDocumentElements = []element{
+		fixed("DocumentTypeCode", 4),
+		variable("ProprietaryDocumentTypeCode", 35),
+		variable("DocumentIdentificationNumber", 35),
+		variable("Issuer", 35),
+	}
+	remittanceAddressElements = []element{
+		fixed("AddressType", 4),
+		variable("Department", 70),
+		variable("SubDepartment", 70),
+		variable("StreetName", 70),
+		variable("BuildingNumber", 16),
+		variable("PostCode", 16),
+		variable("TownName", 35),
+		variable("CountrySubDivisionState", 35),
+		fixed("Country", 2),
+		variable("AddressLineOne", 70),
+		variable("AddressLineTwo", 70),
+		variable("AddressLineThree", 70),
+		variable("AddressLineFour", 70),
+		variable("AddressLineFive", 70),
+		variable("AddressLineSix", 70),
+		variable("AddressLineSeven", 70),
+	}
+)
+
+// concat returns a single slice of all elements
+func concat(elements ...[]element) []element {
+	var out []element
+	for i := range elements {
+		out = append(out, elements[i]...)
+	}
+	return out
+}
+
+// tagElements holds the elements which make up each tag, excluding the tag itself.
+//
+// UnstructuredAddenda {8200} is not included as its length is defined by its AddendaLength element.
+var tagElements = map[string][]element{
+	TagMessageDisposition: {
+		fixed("FormatVersion", 2),
+		fixed("TestProductionCode", 1),
+		fixed("MessageDuplicationCode", 1),
+		fixed("MessageStatusIndicator", 1),
+	},
+	TagReceiptTimeStamp: {
+		fixed("ReceiptDate", 4),
+		fixed("ReceiptTime", 4),
+		fixed("ReceiptApplicationIdentification", 4),
+	},
+	TagOutputMessageAccountabilityData: {
+		fixed("OutputCycleDate", 8),
+		fixed("OutputDestinationID", 8),
+		fixed("OutputSequenceNumber", 6),
+		fixed("OutputDate", 4),
+		fixed("OutputTime", 4),
+		fixed("OutputFRBApplicationIdentification", 4),
+	},
+	TagErrorWire: {
+		fixed("ErrorCategory", 1),
+		fixed("ErrorCode", 3),
+		variable("ErrorDescription", 35),
+	},
+	TagSenderSupplied: {
+		fixed("FormatVersion", 2),
+		fixed("UserRequestCorrelation", 8),
+		fixed("TestProductionCode", 1),
+		fixed("MessageDuplicationCode", 1),
+	},
+	TagTypeSubType: {
+		fixed("TypeCode", 2),
+		fixed("SubTypeCode", 2),
+	},
+	TagInputMessageAccountabilityData: {
+		fixed("InputCycleDate", 8),
+		fixed("InputSource", 8),
+		fixed("InputSequenceNumber", 6),
+	},
+	TagAmount: {
+		fixed("Amount", 12),
+	},
+	TagSenderDepositoryInstitution: {
+		fixed("SenderABANumber", 9),
+		variable("SenderShortName", 18),
+	},
+	TagReceiverDepositoryInstitution: {
+		fixed("ReceiverABANumber", 9),
+		variable("ReceiverShortName", 18),
+	},
+	TagBusinessFunctionCode: {
+		fixed("BusinessFunctionCode", 3),
+		variable("TransactionTypeCode", 3),
+	},
+	TagSenderReference: {
+		variable("SenderReference", 16),
+	},
+	TagPreviousMessageIdentifier: {
+		variable("PreviousMessageIdentifier", 22),
+	},
+	TagLocalInstrument: {
+		fixed("LocalInstrumentCode", 4),
+		variable("ProprietaryCode", 35),
+	},
+	TagPaymentNotification: {
+		fixed("PaymentNotificationIndicator", 1),
+		variable("ContactNotificationElectronicAddress", 2048),
+		variable("ContactName", 140),
+		variable("ContactPhoneNumber", 35),
+		variable("ContactMobileNumber", 35),
+		variable("ContactFaxNumber", 35),
+		variable("EndToEndIdentification", 35),
+	},
+	TagCharges: {
+		fixed("ChargeDetails", 1),
+		variable("SendersChargesOne", 15),
+		variable("SendersChargesTwo", 15),
+		variable("SendersChargesThree", 15),
+		variable("SendersChargesFour", 15),
+	},
+	TagInstructedAmount: {
+		fixed("CurrencyCode", 3),
+		variable("Amount", 15),
+	},
+	TagExchangeRate: {
+		variable("ExchangeRate", 12),
+	},
+	TagBeneficiaryIntermediaryFI: financialInstitutionElements,
+	TagBeneficiaryFI:             financialInstitutionElements,
+	TagBeneficiary:               financialInstitutionElements,
+	TagBeneficiaryReference: {
+		variable("BeneficiaryReference", 16),
+	},
+	TagAccountDebitedDrawdown: financialInstitutionElements,
+	TagOriginator:             financialInstitutionElements,
+	TagOriginatorOptionF: {
+		variable("PartyIdentifier", 35),
+		variable("Name", 35),
+		variable("LineOne", 35),
+		variable("LineTwo", 35),
+		variable("LineThree", 35),
+	},
+	TagOriginatorFI:  financialInstitutionElements,
+	TagInstructingFI: financialInstitutionElements,
+	TagAccountCreditedDrawdown: {
+		fixed("DrawdownCreditAccountNumber", 9),
+	},
+	TagOriginatorToBeneficiary: {
+		variable("LineOne", 35),
+		variable("LineTwo", 35),
+		variable("LineThree", 35),
+		variable("LineFour", 35),
+	},
+	TagFIReceiverFI:                 fiToFIElements,
+	TagFIDrawdownDebitAccountAdvice: adviceElements,
+	TagFIIntermediaryFI:             fiToFIElements,
+	TagFIIntermediaryFIAdvice:       adviceElements,
+	TagFIBeneficiaryFI:              fiToFIElements,
+	TagFIBeneficiaryFIAdvice:        adviceElements,
+	TagFIBeneficiary:                fiToFIElements,
+	TagFIBeneficiaryAdvice:          adviceElements,
+	TagFIPaymentMethodToBeneficiary: {
+		fixed("PaymentMethod", 5),
+		variable("AdditionalInformation", 30),
+	},
+	TagFIAdditionalFIToFI: {
+		variable("LineOne", 35),
+		variable("LineTwo", 35),
+		variable("LineThree", 35),
+		variable("LineFour", 35),
+		variable("LineFive", 35),
+		variable("LineSix", 35),
+	},
+	TagCurrencyInstructedAmount: {
+		fixed("SwiftFieldTag", 5),
+		variable("Amount", 18),
+	},
+	TagOrderingCustomer:        coverPaymentElements,
+	TagOrderingInstitution:     coverPaymentElements,
+	TagIntermediaryInstitution: coverPaymentElements,
+	TagInstitutionAccount:      coverPaymentElements,
+	TagBeneficiaryCustomer:     coverPaymentElements,
+	TagRemittance:              coverPaymentElements[:5],
+	TagSenderToReceiver: concat(coverPaymentElements, []element{
+		variable("SwiftLineSix", 35),
+	}),
+	TagRelatedRemittance: concat([]element{
+		variable("RemittanceIdentification", 35),
+		fixed("RemittanceLocationMethod", 4),
+		variable("RemittanceLocationElectronicAddress", 2048),
+		variable("Name", 140),
+	}, remittanceAddressElements),
+	TagRemittanceOriginator: concat([]element{
+		fixed("IdentificationType", 2),
+		fixed("IdentificationCode", 4),
+		variable("Name", 140),
+		variable("IdentificationNumber", 35),
+		variable("IdentificationNumberIssuer", 35),
+		variable("DateBirthPlace", 82),
+	}, remittanceAddressElements, []element{
+		fixed("CountryOfResidence", 2),
+		variable("ContactName", 140),
+		variable("ContactPhoneNumber", 35),
+		variable("ContactMobileNumber", 35),
+		variable("ContactFaxNumber", 35),
+		variable("ContactElectronicAddress", 2048),
+		variable("ContactOther", 35),
+	}),
+	TagRemittanceBeneficiary: concat([]element{
+		variable("Name", 140),
+		fixed("IdentificationType", 2),
+		fixed("IdentificationCode", 4),
+		variable("IdentificationNumber", 35),
+		variable("IdentificationNumberIssuer", 35),
+		variable("DateBirthPlace", 82),
+	}, remittanceAddressElements, []element{
+		fixed("CountryOfResidence", 2),
+	}),
+	TagPrimaryRemittanceDocument:     remittanceDocumentElements,
+	TagActualAmountPaid:              remittanceAmountElements,
+	TagGrossAmountRemittanceDocument: remittanceAmountElements,
+	TagAmountNegotiatedDiscount:      remittanceAmountElements,
+	TagAdjustment: concat([]element{
+		fixed("AdjustmentReasonCode", 2),
+		fixed("CreditDebitIndicator", 4),
+	}, remittanceAmountElements, []element{
+		variable("AdditionalInfo", 140),
+	}),
+	TagDateRemittanceDocument: {
+		fixed("DateRemittanceDocument", 8),
+	},
+	TagSecondaryRemittanceDocument: remittanceDocumentElements,
+	TagRemittanceFreeText: {
+		variable("LineOne", 140),
+		variable("LineTwo", 140),
+		variable("LineThree", 140),
+	},
+	TagServiceMessage: {
+		variable("LineOne", 35),
+		variable("LineTwo", 35),
+		variable("LineThree", 35),
+		variable("LineFour", 35),
+		variable("LineFive", 35),
+		variable("LineSix", 35),
+		variable("LineSeven", 35),
+		variable("LineEight", 35),
+		variable("LineNine", 35),
+		variable("LineTen", 35),
+		variable("LineEleven", 35),
+		variable("LineTwelve", 35),
+	},
+}
+
+// recordLength returns the length of a tag in the fixed width format, including the tag itself.
+func recordLength(tag string) int {
+	elements, ok := tagElements[tag]
+	if !ok {
+		return 0
+	}
+	length := len(tag)
+	for i := range elements {
+		length += elements[i].Length
+	}
+	return length
+}
+
+// isDelimited reports whether record is a tag in the FAIM delimited format. A delimited record is the length
+// of the fixed width format when its variable elements are shortened by as many characters as it has
+// delimiters, e.g. {3320}ABCDEFGHIJKLMNO* is a 15 character SenderReference, so such a record is delimited when
+// each Delimiter terminates a variable element as delimit writes them.
+func isDelimited(record string) bool {
+	if utf8.RuneCountInString(record) < 6 {
+		return false
+	}
+	length := recordLength(record[:6])
+	if length == 0 || !strings.Contains(record[6:], Delimiter) {
+		return false
+	}
+	if utf8.RuneCountInString(record) != length {
+		return true
+	}
+	expanded, ok := expand(record)
+	return ok && delimit(expanded) == record
+}
+
+// expandDelimited converts a tag in the FAIM delimited format into the fixed width format. Omitted trailing
+// elements are space filled. The second return value is false when record cannot be expanded, in which case
+// the record is returned unchanged.
+func expandDelimited(record string) (string, bool) {
+	if !isDelimited(record) {
+		return record, false
+	}
+	return expand(record)
+}
+
+// expand converts record into the fixed width format as though it is delimited
+func expand(record string) (string, bool) {
+	tag := record[:6]
+	elements := tagElements[tag]
+
+	var buf strings.Builder
+	buf.Grow(recordLength(tag))
+	buf.WriteString(tag)
+
+	data := record[6:]
+	for _, e := range elements {
+		value := data
+		if e.Variable {
+			end := e.Length + 1
+			if end > len(data) {
+				end = len(data)
+			}
+			if idx := strings.Index(data[:end], Delimiter); idx >= 0 {
+				value = data[:idx]
+				data = data[idx+1:]
+			} else if len(data) > e.Length {
+				value = data[:e.Length]
+				data = data[e.Length:]
+			} else {
+				data = ""
+			}
+		} else {
+			if len(data) > e.Length {
+				value = data[:e.Length]
+				data = data[e.Length:]
+			} else {
+				data = ""
+			}
+		}
+		buf.WriteString(value)
+		buf.WriteString(strings.Repeat(" ", e.Length-len(value)))
+	}
+	if data != "" {
+		// more data than the tag holds
+		return record, false
+	}
+	return buf.String(), true
+}
+
+// delimit converts a tag in the fixed width format into the FAIM delimited format, where each variable length
+// element has its trailing spaces removed and is terminated by a Delimiter. Tags which are not of the expected
+// length, or with a variable length element holding a Delimiter, are returned unchanged.
+func delimit(record string) string {
+	if utf8.RuneCountInString(record) < 6 {
+		return record
+	}
+	tag := record[:6]
+	if length := recordLength(tag); length == 0 || len(record) != length {
+		return record
+	}
+
+	var buf strings.Builder
+	buf.Grow(len(record))
+	buf.WriteString(tag)
+
+	offset := 6
+	for _, e := range tagElements[tag] {
+		value := record[offset : offset+e.Length]
+		offset += e.Length
+		if e.Variable {
+			if strings.Contains(value, Delimiter) {
+				// the Delimiter would end the element early
+				return record
+			}
+			buf.WriteString(strings.TrimRight(value, " "))
+			buf.WriteString(Delimiter)
+		} else {
+			buf.WriteString(value)
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,259 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// mockTags returns a mock of every tag which is described by tagElements
+func mockTags() map[string]fmt.Stringer {
+	return map[string]fmt.Stringer{
+		TagMessageDisposition:              mockMessageDisposition(),
+		TagReceiptTimeStamp:                mockReceiptTimeStamp(),
+		TagOutputMessageAccountabilityData: mockOutputMessageAccountabilityData(),
+		TagErrorWire:                       mockErrorWire(),
+		TagSenderSupplied:                  mockSenderSupplied(),
+		TagTypeSubType:                     mockTypeSubType(),
+		TagInputMessageAccountabilityData:  mockInputMessageAccountabilityData(),
+		TagAmount:                          mockAmount(),
+		TagSenderDepositoryInstitution:     mockSenderDepositoryInstitution(),
+		TagReceiverDepositoryInstitution:   mockReceiverDepositoryInstitution(),
+		TagBusinessFunctionCode:            mockBusinessFunctionCode(),
+		TagSenderReference:                 mockSenderReference(),
+		TagPreviousMessageIdentifier:       mockPreviousMessageIdentifier(),
+		TagLocalInstrument:                 mockLocalInstrument(),
+		TagPaymentNotification:             mockPaymentNotification(),
+		TagCharges:                         mockCharges(),
+		TagInstructedAmount:                mockInstructedAmount(),
+		TagExchangeRate:                    mockExchangeRate(),
+		TagBeneficiaryIntermediaryFI:       mockBeneficiaryIntermediaryFI(),
+		TagBeneficiaryFI:                   mockBeneficiaryFI(),
+		TagBeneficiary:                     mockBeneficiary(),
+		TagBeneficiaryReference:            mockBeneficiaryReference(),
+		TagAccountDebitedDrawdown:          mockAccountDebitedDrawdown(),
+		TagOriginator:                      mockOriginator(),
+		TagOriginatorOptionF:               mockOriginatorOptionF(),
+		TagOriginatorFI:                    mockOriginatorFI(),
+		TagInstructingFI:                   mockInstructingFI(),
+		TagAccountCreditedDrawdown:         mockAccountCreditedDrawdown(),
+		TagOriginatorToBeneficiary:         mockOriginatorToBeneficiary(),
+		TagFIReceiverFI:                    mockFIReceiverFI(),
+		TagFIDrawdownDebitAccountAdvice:    mockFIDrawdownDebitAccountAdvice(),
+		TagFIIntermediaryFI:                mockFIIntermediaryFI(),
+		TagFIIntermediaryFIAdvice:          mockFIIntermediaryFIAdvice(),
+		TagFIBeneficiaryFI:                 mockFIBeneficiaryFI(),
+		TagFIBeneficiaryFIAdvice:           mockFIBeneficiaryFIAdvice(),
+		TagFIBeneficiary:                   mockFIBeneficiary(),
+		TagFIBeneficiaryAdvice:             mockFIBeneficiaryAdvice(),
+		TagFIPaymentMethodToBeneficiary:    mockFIPaymentMethodToBeneficiary(),
+		TagFIAdditionalFIToFI:              mockFIAdditionalFIToFI(),
+		TagCurrencyInstructedAmount:        mockCurrencyInstructedAmount(),
+		TagOrderingCustomer:                mockOrderingCustomer(),
+		TagOrderingInstitution:             mockOrderingInstitution(),
+		TagIntermediaryInstitution:         mockIntermediaryInstitution(),
+		TagInstitutionAccount:              mockInstitutionAccount(),
+		TagBeneficiaryCustomer:             mockBeneficiaryCustomer(),
+		TagRemittance:                      mockRemittance(),
+		TagSenderToReceiver:                mockSenderToReceiver(),
+		TagRelatedRemittance:               mockRelatedRemittance(),
+		TagRemittanceOriginator:            mockRemittanceOriginator(),
+		TagRemittanceBeneficiary:           mockRemittanceBeneficiary(),
+		TagPrimaryRemittanceDocument:       mockPrimaryRemittanceDocument(),
+		TagActualAmountPaid:                mockActualAmountPaid(),
+		TagGrossAmountRemittanceDocument:   mockGrossAmountRemittanceDocument(),
+		TagAmountNegotiatedDiscount:        mockAmountNegotiatedDiscount(),
+		TagAdjustment:                      mockAdjustment(),
+		TagDateRemittanceDocument:          mockDateRemittanceDocument(),
+		TagSecondaryRemittanceDocument:     mockSecondaryRemittanceDocument(),
+		TagRemittanceFreeText:              mockRemittanceFreeText(),
+		TagServiceMessage:                  mockServiceMessage(),
+	}
+}
+
+// parseTag parses record into a new value of the same type as tag
+func parseTag(tag fmt.Stringer, record string) (fmt.Stringer, error) {
+	v := reflect.New(reflect.TypeOf(tag).Elem())
+	out := v.MethodByName("Parse").Call([]reflect.Value{reflect.ValueOf(record)})
+	if len(out) == 1 && !out[0].IsNil() {
+		return nil, out[0].Interface().(error)
+	}
+	return v.Interface().(fmt.Stringer), nil
+}
+
+// TestDelimited_RoundTrip writes every tag in the delimited format and parses it back
+func TestDelimited_RoundTrip(t *testing.T) {
+	tags := mockTags()
+	if len(tags) != len(tagElements) {
+		t.Fatalf("got %d mock tags for %d tags", len(tags), len(tagElements))
+	}
+	for name, tag := range tags {
+		if n := recordLength(name); n != len(tag.String()) {
+			t.Errorf("%s: record length %d, expected %d", name, len(tag.String()), n)
+			continue
+		}
+		// parse the fixed width format first as parsing trims each element
+		tag, err := parseTag(tag, tag.String())
+		if err != nil {
+			t.Errorf("%s: %T: %s", name, err, err)
+			continue
+		}
+		record := tag.String()
+		delimited := delimit(record)
+		expanded, ok := expandDelimited(delimited)
+		if !ok && delimited != record {
+			t.Errorf("%s: unable to expand %q", name, delimited)
+			continue
+		}
+		parsed, err := parseTag(tag, expanded)
+		if err != nil {
+			t.Errorf("%s: %T: %s", name, err, err)
+			continue
+		}
+		if got := parsed.String(); got != record {
+			t.Errorf("%s: got\n%q\nexpected\n%q", name, got, record)
+		}
+	}
+}
+
+// TestDelimited_Expand validates omitted trailing elements are space filled
+func TestDelimited_Expand(t *testing.T) {
+	record, ok := expandDelimited("{3100}121042882Wells Fargo NA*")
+	if !ok {
+		t.Fatal("unable to expand record")
+	}
+	sdi := NewSenderDepositoryInstitution()
+	if err := sdi.Parse(record); err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if sdi.SenderABANumber != "121042882" || sdi.SenderShortName != "Wells Fargo NA" {
+		t.Errorf("unexpected SenderDepositoryInstitution %#v", sdi)
+	}
+
+	record, ok = expandDelimited("{5000}D123456789*Name*")
+	if !ok {
+		t.Fatal("unable to expand record")
+	}
+	o := NewOriginator()
+	if err := o.Parse(record); err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if o.Personal.Name != "Name" || o.Personal.Address.AddressLineOne != "" {
+		t.Errorf("unexpected Originator %#v", o)
+	}
+}
+
+// TestDelimited_ExpandInvalid validates records which cannot be expanded are returned unchanged
+func TestDelimited_ExpandInvalid(t *testing.T) {
+	for _, record := range []string{
+		"{31",
+		"{3100}121042882",
+		"{3100}121042882*Wells Fargo NA*Extra*",
+		"{9999}Unknown*",
+		mockSenderDepositoryInstitution().String(),
+	} {
+		if got, ok := expandDelimited(record); ok || got != record {
+			t.Errorf("%q: expanded to %q", record, got)
+		}
+	}
+}
+
+// TestDelimited_ReadWrite writes each test file in the delimited format and reads it back
+func TestDelimited_ReadWrite(t *testing.T) {
+	for _, name := range []string{
+		"BankDrawDownRequest",
+		"BankTransfer",
+		"CheckSameDaySettlement",
+		"CustomerCorporateDrawDownRequest",
+		"CustomerTransfer",
+		"CustomerTransferPlus",
+		"CustomerTransferPlusCOVS",
+		"CustomerTransferPlusStructuredRemittance",
+		"CustomerTransferPlusUnstructuredAddenda",
+		"DepositSendersAccount",
+		"DrawDownRequest",
+		"FEDFundsReturned",
+		"FEDFundsSold",
+		"ServiceMessage",
+	} {
+		match := filepath.Join("test", "testdata", "fedWireMessage-"+name+".txt")
+		fd, err := os.Open(match)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file, err := NewReader(fd).Read()
+		fd.Close()
+		if err != nil {
+			t.Fatalf("%s: %T: %s", match, err, err)
+		}
+
+		var fixed, delimited bytes.Buffer
+		if err := NewWriter(&fixed).Write(&file); err != nil {
+			t.Fatalf("%s: %T: %s", match, err, err)
+		}
+		if err := NewWriter(&delimited, VariableLengthFields(true)).Write(&file); err != nil {
+			t.Fatalf("%s: %T: %s", match, err, err)
+		}
+		if delimited.Len() >= fixed.Len() {
+			t.Errorf("%s: delimited output is not shorter than fixed width output", match)
+		}
+
+		read, err := NewReader(strings.NewReader(delimited.String())).Read()
+		if err != nil {
+			t.Fatalf("%s: %T: %s", match, err, err)
+		}
+		var got bytes.Buffer
+		if err := NewWriter(&got).Write(&read); err != nil {
+			t.Fatalf("%s: %T: %s", match, err, err)
+		}
+		if got.String() != fixed.String() {
+			t.Errorf("%s: got\n%s\nexpected\n%s", match, got.String(), fixed.String())
+		}
+	}
+}
+
+// TestDelimited_MaxWidthLessOne validates a variable length element one character shorter than its width, whose
+// delimited record is the length of the fixed width format, is written and read back
+func TestDelimited_MaxWidthLessOne(t *testing.T) {
+	record, ok := expandDelimited("{3320}ABCDEFGHIJKLMNO*")
+	if !ok || record != "{3320}ABCDEFGHIJKLMNO " {
+		t.Errorf("expanded to %q", record)
+	}
+	// a fixed width record holding a Delimiter which does not terminate the element
+	if record, ok := expandDelimited("{3320}ABC*EFGHIJKLMNOP"); ok {
+		t.Errorf("expanded to %q", record)
+	}
+
+	for _, senderReference := range []string{"ABCDEFGHIJKLMNO", "ABC*EFG", "ABCDEFGHIJKLMN*"} {
+		fd, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		file, err := NewReader(fd).Read()
+		fd.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.FEDWireMessages[0].SenderReference.SenderReference = senderReference
+
+		var buf bytes.Buffer
+		if err := NewWriter(&buf, VariableLengthFields(true)).Write(&file); err != nil {
+			t.Fatalf("%s: %T: %s", senderReference, err, err)
+		}
+		read, err := NewReader(strings.NewReader(buf.String())).Read()
+		if err != nil {
+			t.Fatalf("%s: %T: %s", senderReference, err, err)
+		}
+		if got := strings.TrimSpace(read.FEDWireMessages[0].SenderReference.SenderReference); got != senderReference {
+			t.Errorf("SenderReference=%q expected %q", got, senderReference)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Changes of a Difference
+const (
+	// DiffAdded is a tag, field or FEDWireMessage which only the second FEDWireMessage or File has
+	DiffAdded = "added"
+	// DiffRemoved is a tag, field or FEDWireMessage which only the first FEDWireMessage or File has
+	DiffRemoved = "removed"
+	// DiffChanged is a field whose value differs
+	DiffChanged = "changed"
+)
+
+// Difference is a difference between two FEDWireMessages, of a whole tag when Field is empty, or of a whole
+// FEDWireMessage of a File when Tag is empty.
+type Difference struct {
+	// MessageIndex is the index of the FEDWireMessage within the File
+	MessageIndex int `json:"messageIndex"`
+	// Change is DiffAdded, DiffRemoved or DiffChanged
+	Change string `json:"change"`
+	// Tag is the tag which differs, such as {2000}
+	Tag string `json:"tag,omitempty"`
+	// Name is the JSON name of the tag, such as amount
+	Name string `json:"name,omitempty"`
+	// Field is the JSON name of the field within the tag, with the names of nested fields separated by dots
+	// such as personal.identifier
+	Field string `json:"field,omitempty"`
+	// From is the value of the field in the first FEDWireMessage
+	From string `json:"from,omitempty"`
+	// To is the value of the field in the second FEDWireMessage
+	To string `json:"to,omitempty"`
+}
+
+func (d Difference) String() string {
+	marker := map[string]string{DiffAdded: "+", DiffRemoved: "-", DiffChanged: "~"}[d.Change]
+	if d.Tag == "" {
+		return fmt.Sprintf("%s message %d", marker, d.MessageIndex+1)
+	}
+	name := d.Name
+	if d.Field != "" {
+		name += "." + d.Field
+	}
+	switch {
+	case d.Field == "":
+		return fmt.Sprintf("%s %s %s", marker, d.Tag, name)
+	case d.Change == DiffAdded:
+		return fmt.Sprintf("%s %s %s: %q", marker, d.Tag, name, d.To)
+	case d.Change == DiffRemoved:
+		return fmt.Sprintf("%s %s %s: %q", marker, d.Tag, name, d.From)
+	}
+	return fmt.Sprintf("%s %s %s: %q -> %q", marker, d.Tag, name, d.From, d.To)
+}
+
+// Differences are the differences between two FEDWireMessages or Files
+type Differences []Difference
+
+// String returns each Difference on its own line, marked + when added, - when removed and ~ when changed. The
+// differences of each FEDWireMessage follow a line naming it when they are of more than the first.
+func (d Differences) String() string {
+	multiple := false
+	for i := range d {
+		multiple = multiple || d[i].MessageIndex > 0
+	}
+	var buf strings.Builder
+	for i := range d {
+		if multiple && d[i].Tag != "" && (i == 0 || d[i-1].MessageIndex != d[i].MessageIndex || d[i-1].Tag == "") {
+			buf.WriteString(fmt.Sprintf("message %d:\n", d[i].MessageIndex+1))
+		}
+		buf.WriteString(d[i].String() + "\n")
+	}
+	return buf.String()
+}
+
+// Diff returns the differences between FEDWireMessages a and b, tag by tag and then field by field of the tags
+// both have, in FAIM order. Fields are named by their JSON names and leading and trailing spaces are ignored, as
+// are the leading zeros of the zero filled numeric fields such as the Amount, so FEDWireMessages differing only
+// in their padding have no differences. Values are otherwise compared in full, even past the width they are
+// written with. An empty field is the same as one which is not set. Repeated UnknownTags are compared in the
+// order they appear.
+func Diff(a, b FEDWireMessage) Differences {
+	return diffMessages(0, a, b)
+}
+
+// DiffFiles returns the differences between each FEDWireMessage of Files a and b, compared by their index
+// within the Files.
+func DiffFiles(a, b *File) Differences {
+	var diffs Differences
+	for i := 0; i < len(a.FEDWireMessages) || i < len(b.FEDWireMessages); i++ {
+		switch {
+		case i >= len(b.FEDWireMessages):
+			diffs = append(diffs, Difference{MessageIndex: i, Change: DiffRemoved})
+		case i >= len(a.FEDWireMessages):
+			diffs = append(diffs, Difference{MessageIndex: i, Change: DiffAdded})
+		default:
+			diffs = append(diffs, diffMessages(i, a.FEDWireMessages[i], b.FEDWireMessages[i])...)
+		}
+	}
+	return diffs
+}
+
+func diffMessages(index int, a, b FEDWireMessage) Differences {
+	var diffs Differences
+	for i := range messageTags {
+		tag := messageTags[i].Tag
+		diffs = append(diffs, diffTag(index, tag, tagJSONName(tag), a.tagValue(tag), b.tagValue(tag))...)
+	}
+	// UnknownTags are compared by their tag, the nth of a tag with the nth of b
+	for _, tag := range unknownTagsOf(a, b) {
+		for n := 0; n < countUnknownTag(a, tag) || n < countUnknownTag(b, tag); n++ {
+			diffs = append(diffs, diffTag(index, tag, "unknownTags", findUnknownTag(a, tag, n), findUnknownTag(b, tag, n))...)
+		}
+	}
+	return diffs
+}
+
+// diffTag returns the differences between the values of tag, a and b, which are nil when the tag is not set
+func diffTag(index int, tag, name string, a, b interface{}) Differences {
+	switch {
+	case a == nil && b == nil:
+		return nil
+	case a == nil:
+		return Differences{{MessageIndex: index, Change: DiffAdded, Tag: tag, Name: name}}
+	case b == nil:
+		return Differences{{MessageIndex: index, Change: DiffRemoved, Tag: tag, Name: name}}
+	}
+
+	var diffs Differences
+	from, to := fieldValues(tag, a), fieldValues(tag, b)
+	for _, f := range from {
+		d := Difference{MessageIndex: index, Tag: tag, Name: name, Field: f.name, From: f.value}
+		switch other, ok := lookupField(to, f.name); {
+		case !ok:
+			d.Change = DiffRemoved
+		case other.compared != f.compared:
+			d.Change = DiffChanged
+			d.To = other.value
+		default:
+			continue
+		}
+		diffs = append(diffs, d)
+	}
+	for _, f := range to {
+		if _, ok := lookupField(from, f.name); !ok {
+			diffs = append(diffs, Difference{MessageIndex: index, Change: DiffAdded, Tag: tag, Name: name, Field: f.name, To: f.value})
+		}
+	}
+	return diffs
+}
+
+// zeroFilledFields are the JSON names of the numeric fields of each tag which are written right-justified and
+// zero filled, so their leading zeros are padding
+var zeroFilledFields = map[string]string{
+	TagAmount:                          "amount",
+	TagCurrencyInstructedAmount:        "amount",
+	TagOutputMessageAccountabilityData: "outputSequenceNumber",
+}
+
+// fieldValue is the value of a field named by its JSON name
+type fieldValue struct {
+	name  string
+	value string
+	// compared is value without the padding of its field
+	compared string
+}
+
+// fieldValues returns the fields of v, the value of tag, which are not empty in the order they are declared,
+// with their leading and trailing spaces removed
+func fieldValues(tag string, v interface{}) []fieldValue {
+	var out []fieldValue
+	appendFieldValues(&out, "", reflect.ValueOf(v))
+	for i := range out {
+		out[i].compared = out[i].value
+		if zeroFilledFields[tag] == out[i].name {
+			if out[i].compared = strings.TrimLeft(out[i].value, "0"); out[i].compared == "" {
+				out[i].compared = "0"
+			}
+		}
+	}
+	return out
+}
+
+func appendFieldValues(out *[]fieldValue, prefix string, v reflect.Value) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				// unexported, such as the tag itself
+				continue
+			}
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			if prefix != "" {
+				name = prefix + "." + name
+			}
+			appendFieldValues(out, name, v.Field(i))
+		}
+	case reflect.String:
+		if s := strings.TrimSpace(v.String()); s != "" {
+			*out = append(*out, fieldValue{name: prefix, value: s})
+		}
+	default:
+		*out = append(*out, fieldValue{name: prefix, value: fmt.Sprint(v.Interface())})
+	}
+}
+
+func lookupField(fields []fieldValue, name string) (fieldValue, bool) {
+	for i := range fields {
+		if fields[i].name == name {
+			return fields[i], true
+		}
+	}
+	return fieldValue{}, false
+}
+
+// tagJSONName returns the JSON name of the FEDWireMessage field which holds tag
+func tagJSONName(tag string) string {
+	f, ok := reflect.TypeOf(FEDWireMessage{}).FieldByName(nameForTag(tag))
+	if !ok {
+		return ""
+	}
+	return strings.Split(f.Tag.Get("json"), ",")[0]
+}
+
+// unknownTagsOf returns the tags of the UnknownTags of a and b, in the order they are first found
+func unknownTagsOf(a, b FEDWireMessage) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, ut := range append(append([]UnknownTag{}, a.UnknownTags...), b.UnknownTags...) {
+		if !seen[ut.Tag] {
+			seen[ut.Tag] = true
+			tags = append(tags, ut.Tag)
+		}
+	}
+	return tags
+}
+
+// countUnknownTag returns the number of UnknownTags of fwm with tag
+func countUnknownTag(fwm FEDWireMessage, tag string) int {
+	n := 0
+	for i := range fwm.UnknownTags {
+		if fwm.UnknownTags[i].Tag == tag {
+			n++
+		}
+	}
+	return n
+}
+
+// findUnknownTag returns the value of the nth UnknownTag of fwm with tag, without its Position, or nil when fwm
+// has fewer
+func findUnknownTag(fwm FEDWireMessage, tag string, n int) interface{} {
+	for i := range fwm.UnknownTags {
+		if fwm.UnknownTags[i].Tag != tag {
+			continue
+		}
+		if n == 0 {
+			return struct {
+				Value string `json:"value"`
+			}{fwm.UnknownTags[i].Value}
+		}
+		n--
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDiff__same(t *testing.T) {
+	a := mockCustomerTransferData()
+	a.SetBeneficiary(mockBeneficiary())
+
+	b := mockCustomerTransferData()
+	ben := mockBeneficiary()
+	ben.Personal.Name = "Name    "
+	b.SetBeneficiary(ben)
+	amt := NewAmount()
+	amt.Amount = "1234567"
+	b.SetAmount(amt)
+
+	if diffs := Diff(a, b); len(diffs) != 0 {
+		t.Errorf("padding differs:\n%s", diffs)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	a := mockCustomerTransferData()
+	a.SetSenderReference(mockSenderReference())
+	ben := mockBeneficiary()
+	ben.Personal.Address.AddressLineThree = ""
+	a.SetBeneficiary(ben)
+
+	b := mockCustomerTransferData()
+	amt := NewAmount()
+	amt.Amount = "000001234568"
+	b.SetAmount(amt)
+	b.SetBeneficiary(mockBeneficiary())
+	b.SetOriginator(mockOriginator())
+
+	diffs := Diff(a, b)
+	expected := Differences{
+		{Change: DiffChanged, Tag: TagAmount, Name: "amount", Field: "amount", From: "000001234567", To: "000001234568"},
+		{Change: DiffRemoved, Tag: TagSenderReference, Name: "senderReference"},
+		{Change: DiffAdded, Tag: TagBeneficiary, Name: "beneficiary", Field: "personal.address.addressLineThree", To: "Address Three"},
+		{Change: DiffAdded, Tag: TagOriginator, Name: "originator"},
+	}
+	if len(diffs) != len(expected) {
+		t.Fatalf("unexpected differences:\n%s", diffs)
+	}
+	for i := range expected {
+		if diffs[i] != expected[i] {
+			t.Errorf("got %#v, expected %#v", diffs[i], expected[i])
+		}
+	}
+
+	text := `~ {2000} amount.amount: "000001234567" -> "000001234568"
+- {3320} senderReference
++ {4200} beneficiary.personal.address.addressLineThree: "Address Three"
++ {5000} originator
+`
+	if diffs.String() != text {
+		t.Errorf("unexpected text:\n%s", diffs)
+	}
+
+	bs, err := json.Marshal(diffs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(bs); s != `{"messageIndex":0,"change":"changed","tag":"{2000}","name":"amount","field":"amount","from":"000001234567","to":"000001234568"}` {
+		t.Errorf("unexpected JSON: %s", s)
+	}
+}
+
+// TestDiff__pastFieldWidth validates values differing only past the width of their field differ
+func TestDiff__pastFieldWidth(t *testing.T) {
+	a := mockCustomerTransferData()
+	b := mockCustomerTransferData()
+	a.SenderSupplied.UserRequestCorrelation = "UserReq1"
+	b.SenderSupplied.UserRequestCorrelation = "UserReq12"
+
+	diffs := Diff(a, b)
+	if len(diffs) != 1 || diffs[0].Field != "userRequestCorrelation" || diffs[0].To != "UserReq12" {
+		t.Errorf("unexpected differences:\n%s", diffs)
+	}
+}
+
+func TestDiff__unknownTags(t *testing.T) {
+	a := mockCustomerTransferData()
+	a.UnknownTags = []UnknownTag{{Tag: "{7777}", Value: "One", Position: 7}}
+	b := mockCustomerTransferData()
+	b.UnknownTags = []UnknownTag{{Tag: "{7777}", Value: "Two", Position: 8}, {Tag: "{7778}", Value: "Three"}}
+
+	diffs := Diff(a, b)
+	if len(diffs) != 2 || diffs[0].Change != DiffChanged || diffs[0].Field != "value" || diffs[1].Change != DiffAdded {
+		t.Errorf("unexpected differences:\n%s", diffs)
+	}
+
+	// repeated UnknownTags are compared in order
+	a.UnknownTags = []UnknownTag{{Tag: "{7777}", Value: "One"}, {Tag: "{7777}", Value: "Two"}}
+	b.UnknownTags = []UnknownTag{{Tag: "{7777}", Value: "One"}, {Tag: "{7777}", Value: "2"}, {Tag: "{7777}", Value: "Three"}}
+	diffs = Diff(a, b)
+	if len(diffs) != 2 || diffs[0].Change != DiffChanged || diffs[0].From != "Two" || diffs[0].To != "2" ||
+		diffs[1].Change != DiffAdded || diffs[1].Tag != "{7777}" {
+		t.Errorf("unexpected differences:\n%s", diffs)
+	}
+}
+
+func TestDiffFiles(t *testing.T) {
+	a, b := NewFile(), NewFile()
+	a.AddFEDWireMessage(mockCustomerTransferData())
+	b.AddFEDWireMessage(mockCustomerTransferData())
+	fwm := mockCustomerTransferData()
+	fwm.SetSenderReference(mockSenderReference())
+	b.AddFEDWireMessage(fwm)
+	a.FEDWireMessages[0].SetSenderReference(mockSenderReference())
+
+	diffs := DiffFiles(a, b)
+	if len(diffs) != 2 || diffs[1] != (Difference{MessageIndex: 1, Change: DiffAdded}) {
+		t.Errorf("unexpected differences:\n%s", diffs)
+	}
+	if s := diffs.String(); s != "message 1:\n- {3320} senderReference\n+ message 2\n" {
+		t.Errorf("unexpected text:\n%s", s)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// DrawdownRequestBuilder builds a drawdown payment (DRW) FEDWireMessage and only sets the tags it may have. The
+// TypeSubType defaults to a funds transfer honoring a request for credit (1032). A drawdown payment honors a
+// customer or corporate drawdown request and requires a Beneficiary and an Originator.
+type DrawdownRequestBuilder struct {
+	messageBuilder
+}
+
+// NewDrawdownRequestBuilder returns a new DrawdownRequestBuilder
+func NewDrawdownRequestBuilder() *DrawdownRequestBuilder {
+	return &DrawdownRequestBuilder{newMessageBuilder(DrawDownRequest, builderTypeSubType(DrawdownRequestFundsPayment))}
+}
+
+// DrawdownRequestTypeSubType is a TypeSubType {1510} a drawdown payment may have
+type DrawdownRequestTypeSubType builderTypeSubType
+
+var (
+	// DrawdownRequestFundsPayment is a funds transfer honoring a request for credit (1032)
+	DrawdownRequestFundsPayment = DrawdownRequestTypeSubType{FundsTransfer, FundsTransferRequestCredit}
+	// DrawdownRequestSettlementPayment is a settlement transfer honoring a request for credit (1632)
+	DrawdownRequestSettlementPayment = DrawdownRequestTypeSubType{SettlementTransfer, FundsTransferRequestCredit}
+)
@@ -0,0 +1,140 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"time"
+)
+
+// easternTimeZone is the name of the time zone of the Fedwire Funds Service
+const easternTimeZone = "America/New_York"
+
+// easternTime returns the Location of Eastern Time, read from the time zone data of the system when it has it and
+// otherwise from easternTimeData, so the Schedule follows daylight saving time without time zone data installed.
+func easternTime() *time.Location {
+	if location, err := time.LoadLocation(easternTimeZone); err == nil {
+		return location
+	}
+	location, err := time.LoadLocationFromTZData(easternTimeZone, []byte(easternTimeData))
+	if err != nil {
+		panic(err)
+	}
+	return location
+}
+
+// easternTimeData is the America/New_York zoneinfo file (TZif) of the IANA time zone database, version 2025b,
+// with its transitions listed through 2037.
+const easternTimeData = "" +
+	"\x54\x5a\x69\x66\x32\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00\x00\x00\x06\x00\x00\x00\x00" +
+	"\x00\x00\x00\xec\x00\x00\x00\x06\x00\x00\x00\x14\x80\x00\x00\x00\x9e\xa6\x1e\x70\x9f\xba\xeb\x60\xa0\x86\x00\x70\xa1\x9a\xcd\x60" +
+	"\xa2\x65\xe2\x70\xa3\x83\xe9\xe0\xa4\x6a\xae\x70\xa5\x35\xa7\x60\xa6\x53\xca\xf0\xa7\x15\x89\x60\xa8\x33\xac\xf0\xa8\xfe\xa5\xe0" +
+	"\xaa\x13\x8e\xf0\xaa\xde\x87\xe0\xab\xf3\x70\xf0\xac\xbe\x69\xe0\xad\xd3\x52\xf0\xae\x9e\x4b\xe0\xaf\xb3\x34\xf0\xb0\x7e\x2d\xe0" +
+	"\xb1\x9c\x51\x70\xb2\x67\x4a\x60\xb3\x7c\x33\x70\xb4\x47\x2c\x60\xb5\x5c\x15\x70\xb6\x27\x0e\x60\xb7\x3b\xf7\x70\xb8\x06\xf0\x60" +
+	"\xb9\x1b\xd9\x70\xb9\xe6\xd2\x60\xbb\x04\xf5\xf0\xbb\xc6\xb4\x60\xbc\xe4\xd7\xf0\xbd\xaf\xd0\xe0\xbe\xc4\xb9\xf0\xbf\x8f\xb2\xe0" +
+	"\xc0\xa4\x9b\xf0\xc1\x6f\x94\xe0\xc2\x84\x7d\xf0\xc3\x4f\x76\xe0\xc4\x64\x5f\xf0\xc5\x2f\x58\xe0\xc6\x4d\x7c\x70\xc7\x0f\x3a\xe0" +
+	"\xc8\x2d\x5e\x70\xc8\xf8\x57\x60\xca\x0d\x40\x70\xca\xd8\x39\x60\xcb\x88\xf0\x70\xd2\x23\xf4\x70\xd2\x60\xfb\xe0\xd3\x75\xe4\xf0" +
+	"\xd4\x40\xdd\xe0\xd5\x55\xc6\xf0\xd6\x20\xbf\xe0\xd7\x35\xa8\xf0\xd8\x00\xa1\xe0\xd9\x15\x8a\xf0\xd9\xe0\x83\xe0\xda\xfe\xa7\x70" +
+	"\xdb\xc0\x65\xe0\xdc\xde\x89\x70\xdd\xa9\x82\x60\xde\xbe\x6b\x70\xdf\x89\x64\x60\xe0\x9e\x4d\x70\xe1\x69\x46\x60\xe2\x7e\x2f\x70" +
+	"\xe3\x49\x28\x60\xe4\x5e\x11\x70\xe5\x57\x2e\xe0\xe6\x47\x2d\xf0\xe7\x37\x10\xe0\xe8\x27\x0f\xf0\xe9\x16\xf2\xe0\xea\x06\xf1\xf0" +
+	"\xea\xf6\xd4\xe0\xeb\xe6\xd3\xf0\xec\xd6\xb6\xe0\xed\xc6\xb5\xf0\xee\xbf\xd3\x60\xef\xaf\xd2\x70\xf0\x9f\xb5\x60\xf1\x8f\xb4\x70" +
+	"\xf2\x7f\x97\x60\xf3\x6f\x96\x70\xf4\x5f\x79\x60\xf5\x4f\x78\x70\xf6\x3f\x5b\x60\xf7\x2f\x5a\x70\xf8\x28\x77\xe0\xf9\x0f\x3c\x70" +
+	"\xfa\x08\x59\xe0\xfa\xf8\x58\xf0\xfb\xe8\x3b\xe0\xfc\xd8\x3a\xf0\xfd\xc8\x1d\xe0\xfe\xb8\x1c\xf0\xff\xa7\xff\xe0\x00\x97\xfe\xf0" +
+	"\x01\x87\xe1\xe0\x02\x77\xe0\xf0\x03\x70\xfe\x60\x04\x60\xfd\x70\x05\x50\xe0\x60\x06\x40\xdf\x70\x07\x30\xc2\x60\x07\x8d\x19\x70" +
+	"\x09\x10\xa4\x60\x09\xad\x94\xf0\x0a\xf0\x86\x60\x0b\xe0\x85\x70\x0c\xd9\xa2\xe0\x0d\xc0\x67\x70\x0e\xb9\x84\xe0\x0f\xa9\x83\xf0" +
+	"\x10\x99\x66\xe0\x11\x89\x65\xf0\x12\x79\x48\xe0\x13\x69\x47\xf0\x14\x59\x2a\xe0\x15\x49\x29\xf0\x16\x39\x0c\xe0\x17\x29\x0b\xf0" +
+	"\x18\x22\x29\x60\x19\x08\xed\xf0\x1a\x02\x0b\x60\x1a\xf2\x0a\x70\x1b\xe1\xed\x60\x1c\xd1\xec\x70\x1d\xc1\xcf\x60\x1e\xb1\xce\x70" +
+	"\x1f\xa1\xb1\x60\x20\x76\x00\xf0\x21\x81\x93\x60\x22\x55\xe2\xf0\x23\x6a\xaf\xe0\x24\x35\xc4\xf0\x25\x4a\x91\xe0\x26\x15\xa6\xf0" +
+	"\x27\x2a\x73\xe0\x27\xfe\xc3\x70\x29\x0a\x55\xe0\x29\xde\xa5\x70\x2a\xea\x37\xe0\x2b\xbe\x87\x70\x2c\xd3\x54\x60\x2d\x9e\x69\x70" +
+	"\x2e\xb3\x36\x60\x2f\x7e\x4b\x70\x30\x93\x18\x60\x31\x67\x67\xf0\x32\x72\xfa\x60\x33\x47\x49\xf0\x34\x52\xdc\x60\x35\x27\x2b\xf0" +
+	"\x36\x32\xbe\x60\x37\x07\x0d\xf0\x38\x1b\xda\xe0\x38\xe6\xef\xf0\x39\xfb\xbc\xe0\x3a\xc6\xd1\xf0\x3b\xdb\x9e\xe0\x3c\xaf\xee\x70" +
+	"\x3d\xbb\x80\xe0\x3e\x8f\xd0\x70\x3f\x9b\x62\xe0\x40\x6f\xb2\x70\x41\x84\x7f\x60\x42\x4f\x94\x70\x43\x64\x61\x60\x44\x2f\x76\x70" +
+	"\x45\x44\x43\x60\x45\xf3\xa8\xf0\x47\x2d\x5f\xe0\x47\xd3\x8a\xf0\x49\x0d\x41\xe0\x49\xb3\x6c\xf0\x4a\xed\x23\xe0\x4b\x9c\x89\x70" +
+	"\x4c\xd6\x40\x60\x4d\x7c\x6b\x70\x4e\xb6\x22\x60\x4f\x5c\x4d\x70\x50\x96\x04\x60\x51\x3c\x2f\x70\x52\x75\xe6\x60\x53\x1c\x11\x70" +
+	"\x54\x55\xc8\x60\x54\xfb\xf3\x70\x56\x35\xaa\x60\x56\xe5\x0f\xf0\x58\x1e\xc6\xe0\x58\xc4\xf1\xf0\x59\xfe\xa8\xe0\x5a\xa4\xd3\xf0" +
+	"\x5b\xde\x8a\xe0\x5c\x84\xb5\xf0\x5d\xbe\x6c\xe0\x5e\x64\x97\xf0\x5f\x9e\x4e\xe0\x60\x4d\xb4\x70\x61\x87\x6b\x60\x62\x2d\x96\x70" +
+	"\x63\x67\x4d\x60\x64\x0d\x78\x70\x65\x47\x2f\x60\x65\xed\x5a\x70\x67\x27\x11\x60\x67\xcd\x3c\x70\x69\x06\xf3\x60\x69\xad\x1e\x70" +
+	"\x6a\xe6\xd5\x60\x6b\x96\x3a\xf0\x6c\xcf\xf1\xe0\x6d\x76\x1c\xf0\x6e\xaf\xd3\xe0\x6f\x55\xfe\xf0\x70\x8f\xb5\xe0\x71\x35\xe0\xf0" +
+	"\x72\x6f\x97\xe0\x73\x15\xc2\xf0\x74\x4f\x79\xe0\x74\xfe\xdf\x70\x76\x38\x96\x60\x76\xde\xc1\x70\x78\x18\x78\x60\x78\xbe\xa3\x70" +
+	"\x79\xf8\x5a\x60\x7a\x9e\x85\x70\x7b\xd8\x3c\x60\x7c\x7e\x67\x70\x7d\xb8\x1e\x60\x7e\x5e\x49\x70\x7f\x98\x00\x60\x03\x01\x02\x01" +
+	"\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01" +
+	"\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x04\x05\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\x01\x02\x01\x02\xff\xff\xba\x9e\x00\x00\xff\xff\xc7\xc0\x01\x04\xff\xff\xb9\xb0\x00\x08\xff\xff\xb9\xb0\x00\x08" +
+	"\xff\xff\xc7\xc0\x01\x0c\xff\xff\xc7\xc0\x01\x10\x4c\x4d\x54\x00\x45\x44\x54\x00\x45\x53\x54\x00\x45\x57\x54\x00\x45\x50\x54\x00" +
+	"\x00\x00\x00\x01\x00\x01\x00\x00\x00\x01\x00\x01\x54\x5a\x69\x66\x32\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00" +
+	"\x00\x00\x00\x06\x00\x00\x00\x06\x00\x00\x00\x00\x00\x00\x00\xec\x00\x00\x00\x06\x00\x00\x00\x14\xff\xff\xff\xff\x5e\x03\xf0\x90" +
+	"\xff\xff\xff\xff\x9e\xa6\x1e\x70\xff\xff\xff\xff\x9f\xba\xeb\x60\xff\xff\xff\xff\xa0\x86\x00\x70\xff\xff\xff\xff\xa1\x9a\xcd\x60" +
+	"\xff\xff\xff\xff\xa2\x65\xe2\x70\xff\xff\xff\xff\xa3\x83\xe9\xe0\xff\xff\xff\xff\xa4\x6a\xae\x70\xff\xff\xff\xff\xa5\x35\xa7\x60" +
+	"\xff\xff\xff\xff\xa6\x53\xca\xf0\xff\xff\xff\xff\xa7\x15\x89\x60\xff\xff\xff\xff\xa8\x33\xac\xf0\xff\xff\xff\xff\xa8\xfe\xa5\xe0" +
+	"\xff\xff\xff\xff\xaa\x13\x8e\xf0\xff\xff\xff\xff\xaa\xde\x87\xe0\xff\xff\xff\xff\xab\xf3\x70\xf0\xff\xff\xff\xff\xac\xbe\x69\xe0" +
+	"\xff\xff\xff\xff\xad\xd3\x52\xf0\xff\xff\xff\xff\xae\x9e\x4b\xe0\xff\xff\xff\xff\xaf\xb3\x34\xf0\xff\xff\xff\xff\xb0\x7e\x2d\xe0" +
+	"\xff\xff\xff\xff\xb1\x9c\x51\x70\xff\xff\xff\xff\xb2\x67\x4a\x60\xff\xff\xff\xff\xb3\x7c\x33\x70\xff\xff\xff\xff\xb4\x47\x2c\x60" +
+	"\xff\xff\xff\xff\xb5\x5c\x15\x70\xff\xff\xff\xff\xb6\x27\x0e\x60\xff\xff\xff\xff\xb7\x3b\xf7\x70\xff\xff\xff\xff\xb8\x06\xf0\x60" +
+	"\xff\xff\xff\xff\xb9\x1b\xd9\x70\xff\xff\xff\xff\xb9\xe6\xd2\x60\xff\xff\xff\xff\xbb\x04\xf5\xf0\xff\xff\xff\xff\xbb\xc6\xb4\x60" +
+	"\xff\xff\xff\xff\xbc\xe4\xd7\xf0\xff\xff\xff\xff\xbd\xaf\xd0\xe0\xff\xff\xff\xff\xbe\xc4\xb9\xf0\xff\xff\xff\xff\xbf\x8f\xb2\xe0" +
+	"\xff\xff\xff\xff\xc0\xa4\x9b\xf0\xff\xff\xff\xff\xc1\x6f\x94\xe0\xff\xff\xff\xff\xc2\x84\x7d\xf0\xff\xff\xff\xff\xc3\x4f\x76\xe0" +
+	"\xff\xff\xff\xff\xc4\x64\x5f\xf0\xff\xff\xff\xff\xc5\x2f\x58\xe0\xff\xff\xff\xff\xc6\x4d\x7c\x70\xff\xff\xff\xff\xc7\x0f\x3a\xe0" +
+	"\xff\xff\xff\xff\xc8\x2d\x5e\x70\xff\xff\xff\xff\xc8\xf8\x57\x60\xff\xff\xff\xff\xca\x0d\x40\x70\xff\xff\xff\xff\xca\xd8\x39\x60" +
+	"\xff\xff\xff\xff\xcb\x88\xf0\x70\xff\xff\xff\xff\xd2\x23\xf4\x70\xff\xff\xff\xff\xd2\x60\xfb\xe0\xff\xff\xff\xff\xd3\x75\xe4\xf0" +
+	"\xff\xff\xff\xff\xd4\x40\xdd\xe0\xff\xff\xff\xff\xd5\x55\xc6\xf0\xff\xff\xff\xff\xd6\x20\xbf\xe0\xff\xff\xff\xff\xd7\x35\xa8\xf0" +
+	"\xff\xff\xff\xff\xd8\x00\xa1\xe0\xff\xff\xff\xff\xd9\x15\x8a\xf0\xff\xff\xff\xff\xd9\xe0\x83\xe0\xff\xff\xff\xff\xda\xfe\xa7\x70" +
+	"\xff\xff\xff\xff\xdb\xc0\x65\xe0\xff\xff\xff\xff\xdc\xde\x89\x70\xff\xff\xff\xff\xdd\xa9\x82\x60\xff\xff\xff\xff\xde\xbe\x6b\x70" +
+	"\xff\xff\xff\xff\xdf\x89\x64\x60\xff\xff\xff\xff\xe0\x9e\x4d\x70\xff\xff\xff\xff\xe1\x69\x46\x60\xff\xff\xff\xff\xe2\x7e\x2f\x70" +
+	"\xff\xff\xff\xff\xe3\x49\x28\x60\xff\xff\xff\xff\xe4\x5e\x11\x70\xff\xff\xff\xff\xe5\x57\x2e\xe0\xff\xff\xff\xff\xe6\x47\x2d\xf0" +
+	"\xff\xff\xff\xff\xe7\x37\x10\xe0\xff\xff\xff\xff\xe8\x27\x0f\xf0\xff\xff\xff\xff\xe9\x16\xf2\xe0\xff\xff\xff\xff\xea\x06\xf1\xf0" +
+	"\xff\xff\xff\xff\xea\xf6\xd4\xe0\xff\xff\xff\xff\xeb\xe6\xd3\xf0\xff\xff\xff\xff\xec\xd6\xb6\xe0\xff\xff\xff\xff\xed\xc6\xb5\xf0" +
+	"\xff\xff\xff\xff\xee\xbf\xd3\x60\xff\xff\xff\xff\xef\xaf\xd2\x70\xff\xff\xff\xff\xf0\x9f\xb5\x60\xff\xff\xff\xff\xf1\x8f\xb4\x70" +
+	"\xff\xff\xff\xff\xf2\x7f\x97\x60\xff\xff\xff\xff\xf3\x6f\x96\x70\xff\xff\xff\xff\xf4\x5f\x79\x60\xff\xff\xff\xff\xf5\x4f\x78\x70" +
+	"\xff\xff\xff\xff\xf6\x3f\x5b\x60\xff\xff\xff\xff\xf7\x2f\x5a\x70\xff\xff\xff\xff\xf8\x28\x77\xe0\xff\xff\xff\xff\xf9\x0f\x3c\x70" +
+	"\xff\xff\xff\xff\xfa\x08\x59\xe0\xff\xff\xff\xff\xfa\xf8\x58\xf0\xff\xff\xff\xff\xfb\xe8\x3b\xe0\xff\xff\xff\xff\xfc\xd8\x3a\xf0" +
+	"\xff\xff\xff\xff\xfd\xc8\x1d\xe0\xff\xff\xff\xff\xfe\xb8\x1c\xf0\xff\xff\xff\xff\xff\xa7\xff\xe0\x00\x00\x00\x00\x00\x97\xfe\xf0" +
+	"\x00\x00\x00\x00\x01\x87\xe1\xe0\x00\x00\x00\x00\x02\x77\xe0\xf0\x00\x00\x00\x00\x03\x70\xfe\x60\x00\x00\x00\x00\x04\x60\xfd\x70" +
+	"\x00\x00\x00\x00\x05\x50\xe0\x60\x00\x00\x00\x00\x06\x40\xdf\x70\x00\x00\x00\x00\x07\x30\xc2\x60\x00\x00\x00\x00\x07\x8d\x19\x70" +
+	"\x00\x00\x00\x00\x09\x10\xa4\x60\x00\x00\x00\x00\x09\xad\x94\xf0\x00\x00\x00\x00\x0a\xf0\x86\x60\x00\x00\x00\x00\x0b\xe0\x85\x70" +
+	"\x00\x00\x00\x00\x0c\xd9\xa2\xe0\x00\x00\x00\x00\x0d\xc0\x67\x70\x00\x00\x00\x00\x0e\xb9\x84\xe0\x00\x00\x00\x00\x0f\xa9\x83\xf0" +
+	"\x00\x00\x00\x00\x10\x99\x66\xe0\x00\x00\x00\x00\x11\x89\x65\xf0\x00\x00\x00\x00\x12\x79\x48\xe0\x00\x00\x00\x00\x13\x69\x47\xf0" +
+	"\x00\x00\x00\x00\x14\x59\x2a\xe0\x00\x00\x00\x00\x15\x49\x29\xf0\x00\x00\x00\x00\x16\x39\x0c\xe0\x00\x00\x00\x00\x17\x29\x0b\xf0" +
+	"\x00\x00\x00\x00\x18\x22\x29\x60\x00\x00\x00\x00\x19\x08\xed\xf0\x00\x00\x00\x00\x1a\x02\x0b\x60\x00\x00\x00\x00\x1a\xf2\x0a\x70" +
+	"\x00\x00\x00\x00\x1b\xe1\xed\x60\x00\x00\x00\x00\x1c\xd1\xec\x70\x00\x00\x00\x00\x1d\xc1\xcf\x60\x00\x00\x00\x00\x1e\xb1\xce\x70" +
+	"\x00\x00\x00\x00\x1f\xa1\xb1\x60\x00\x00\x00\x00\x20\x76\x00\xf0\x00\x00\x00\x00\x21\x81\x93\x60\x00\x00\x00\x00\x22\x55\xe2\xf0" +
+	"\x00\x00\x00\x00\x23\x6a\xaf\xe0\x00\x00\x00\x00\x24\x35\xc4\xf0\x00\x00\x00\x00\x25\x4a\x91\xe0\x00\x00\x00\x00\x26\x15\xa6\xf0" +
+	"\x00\x00\x00\x00\x27\x2a\x73\xe0\x00\x00\x00\x00\x27\xfe\xc3\x70\x00\x00\x00\x00\x29\x0a\x55\xe0\x00\x00\x00\x00\x29\xde\xa5\x70" +
+	"\x00\x00\x00\x00\x2a\xea\x37\xe0\x00\x00\x00\x00\x2b\xbe\x87\x70\x00\x00\x00\x00\x2c\xd3\x54\x60\x00\x00\x00\x00\x2d\x9e\x69\x70" +
+	"\x00\x00\x00\x00\x2e\xb3\x36\x60\x00\x00\x00\x00\x2f\x7e\x4b\x70\x00\x00\x00\x00\x30\x93\x18\x60\x00\x00\x00\x00\x31\x67\x67\xf0" +
+	"\x00\x00\x00\x00\x32\x72\xfa\x60\x00\x00\x00\x00\x33\x47\x49\xf0\x00\x00\x00\x00\x34\x52\xdc\x60\x00\x00\x00\x00\x35\x27\x2b\xf0" +
+	"\x00\x00\x00\x00\x36\x32\xbe\x60\x00\x00\x00\x00\x37\x07\x0d\xf0\x00\x00\x00\x00\x38\x1b\xda\xe0\x00\x00\x00\x00\x38\xe6\xef\xf0" +
+	"\x00\x00\x00\x00\x39\xfb\xbc\xe0\x00\x00\x00\x00\x3a\xc6\xd1\xf0\x00\x00\x00\x00\x3b\xdb\x9e\xe0\x00\x00\x00\x00\x3c\xaf\xee\x70" +
+	"\x00\x00\x00\x00\x3d\xbb\x80\xe0\x00\x00\x00\x00\x3e\x8f\xd0\x70\x00\x00\x00\x00\x3f\x9b\x62\xe0\x00\x00\x00\x00\x40\x6f\xb2\x70" +
+	"\x00\x00\x00\x00\x41\x84\x7f\x60\x00\x00\x00\x00\x42\x4f\x94\x70\x00\x00\x00\x00\x43\x64\x61\x60\x00\x00\x00\x00\x44\x2f\x76\x70" +
+	"\x00\x00\x00\x00\x45\x44\x43\x60\x00\x00\x00\x00\x45\xf3\xa8\xf0\x00\x00\x00\x00\x47\x2d\x5f\xe0\x00\x00\x00\x00\x47\xd3\x8a\xf0" +
+	"\x00\x00\x00\x00\x49\x0d\x41\xe0\x00\x00\x00\x00\x49\xb3\x6c\xf0\x00\x00\x00\x00\x4a\xed\x23\xe0\x00\x00\x00\x00\x4b\x9c\x89\x70" +
+	"\x00\x00\x00\x00\x4c\xd6\x40\x60\x00\x00\x00\x00\x4d\x7c\x6b\x70\x00\x00\x00\x00\x4e\xb6\x22\x60\x00\x00\x00\x00\x4f\x5c\x4d\x70" +
+	"\x00\x00\x00\x00\x50\x96\x04\x60\x00\x00\x00\x00\x51\x3c\x2f\x70\x00\x00\x00\x00\x52\x75\xe6\x60\x00\x00\x00\x00\x53\x1c\x11\x70" +
+	"\x00\x00\x00\x00\x54\x55\xc8\x60\x00\x00\x00\x00\x54\xfb\xf3\x70\x00\x00\x00\x00\x56\x35\xaa\x60\x00\x00\x00\x00\x56\xe5\x0f\xf0" +
+	"\x00\x00\x00\x00\x58\x1e\xc6\xe0\x00\x00\x00\x00\x58\xc4\xf1\xf0\x00\x00\x00\x00\x59\xfe\xa8\xe0\x00\x00\x00\x00\x5a\xa4\xd3\xf0" +
+	"\x00\x00\x00\x00\x5b\xde\x8a\xe0\x00\x00\x00\x00\x5c\x84\xb5\xf0\x00\x00\x00\x00\x5d\xbe\x6c\xe0\x00\x00\x00\x00\x5e\x64\x97\xf0" +
+	"\x00\x00\x00\x00\x5f\x9e\x4e\xe0\x00\x00\x00\x00\x60\x4d\xb4\x70\x00\x00\x00\x00\x61\x87\x6b\x60\x00\x00\x00\x00\x62\x2d\x96\x70" +
+	"\x00\x00\x00\x00\x63\x67\x4d\x60\x00\x00\x00\x00\x64\x0d\x78\x70\x00\x00\x00\x00\x65\x47\x2f\x60\x00\x00\x00\x00\x65\xed\x5a\x70" +
+	"\x00\x00\x00\x00\x67\x27\x11\x60\x00\x00\x00\x00\x67\xcd\x3c\x70\x00\x00\x00\x00\x69\x06\xf3\x60\x00\x00\x00\x00\x69\xad\x1e\x70" +
+	"\x00\x00\x00\x00\x6a\xe6\xd5\x60\x00\x00\x00\x00\x6b\x96\x3a\xf0\x00\x00\x00\x00\x6c\xcf\xf1\xe0\x00\x00\x00\x00\x6d\x76\x1c\xf0" +
+	"\x00\x00\x00\x00\x6e\xaf\xd3\xe0\x00\x00\x00\x00\x6f\x55\xfe\xf0\x00\x00\x00\x00\x70\x8f\xb5\xe0\x00\x00\x00\x00\x71\x35\xe0\xf0" +
+	"\x00\x00\x00\x00\x72\x6f\x97\xe0\x00\x00\x00\x00\x73\x15\xc2\xf0\x00\x00\x00\x00\x74\x4f\x79\xe0\x00\x00\x00\x00\x74\xfe\xdf\x70" +
+	"\x00\x00\x00\x00\x76\x38\x96\x60\x00\x00\x00\x00\x76\xde\xc1\x70\x00\x00\x00\x00\x78\x18\x78\x60\x00\x00\x00\x00\x78\xbe\xa3\x70" +
+	"\x00\x00\x00\x00\x79\xf8\x5a\x60\x00\x00\x00\x00\x7a\x9e\x85\x70\x00\x00\x00\x00\x7b\xd8\x3c\x60\x00\x00\x00\x00\x7c\x7e\x67\x70" +
+	"\x00\x00\x00\x00\x7d\xb8\x1e\x60\x00\x00\x00\x00\x7e\x5e\x49\x70\x00\x00\x00\x00\x7f\x98\x00\x60\x03\x01\x02\x01\x02\x01\x02\x01" +
+	"\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01" +
+	"\x02\x01\x02\x01\x02\x01\x02\x01\x02\x04\x05\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02" +
+	"\x01\x02\x01\x02\xff\xff\xba\x9e\x00\x00\xff\xff\xc7\xc0\x01\x04\xff\xff\xb9\xb0\x00\x08\xff\xff\xb9\xb0\x00\x08\xff\xff\xc7\xc0" +
+	"\x01\x0c\xff\xff\xc7\xc0\x01\x10\x4c\x4d\x54\x00\x45\x44\x54\x00\x45\x53\x54\x00\x45\x57\x54\x00\x45\x50\x54\x00\x00\x00\x00\x01" +
+	"\x00\x01\x00\x00\x00\x01\x00\x01\x0a\x45\x53\x54\x35\x45\x44\x54\x2c\x4d\x33\x2e\x32\x2e\x30\x2c\x4d\x31\x31\x2e\x31\x2e\x30\x0a"
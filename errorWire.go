@@ -4,7 +4,10 @@
 
 package wire
 
-import "strings"
+import (
+	"strings"
+	"unicode/utf8"
+)
 
 // ErrorWire is a wire error with the fedwire message
 type ErrorWire struct {
@@ -18,7 +21,7 @@ type ErrorWire struct {
 	ErrorDescription string `json:"errorDescription,omitempty"`
 
 	// validator is composed for data validation
-	// validator
+	validator
 	// converters is composed for WIRE to GoLang Converters
 	converters
 }
@@ -31,15 +34,24 @@ func NewErrorWire() *ErrorWire {
 	return ew
 }
 
+// setTag sets the tag of ErrorWire, which JSON does not hold
+func (ew *ErrorWire) setTag() {
+	ew.tag = TagErrorWire
+}
+
 // Parse takes the input string and parses the ErrorWire values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
-func (ew *ErrorWire) Parse(record string) {
+func (ew *ErrorWire) Parse(record string) error {
+	if utf8.RuneCountInString(record) != 45 {
+		return NewTagWrongLengthErr(45, utf8.RuneCountInString(record))
+	}
 	ew.tag = record[:6]
 	ew.ErrorCategory = ew.parseStringField(record[6:7])
 	ew.ErrorCode = ew.parseStringField(record[7:10])
 	ew.ErrorDescription = ew.parseStringField(record[10:45])
+	return nil
 }
 
 // String writes ErrorWire
@@ -56,7 +68,18 @@ func (ew *ErrorWire) String() string {
 // Validate performs WIRE format rule checks on ErrorWire and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ew *ErrorWire) Validate() error {
-	// Currently no validation as the FED is responsible for the values
+	if ew.tag != TagErrorWire {
+		return fieldError("tag", ErrValidTagForType, ew.tag)
+	}
+	if err := ew.isErrorCategory(ew.ErrorCategory); err != nil {
+		return fieldError("ErrorCategory", err, ew.ErrorCategory)
+	}
+	if err := ew.isAlphanumeric(ew.ErrorCode); err != nil {
+		return fieldError("ErrorCode", err, ew.ErrorCode)
+	}
+	if err := ew.isAlphanumeric(ew.ErrorDescription); err != nil {
+		return fieldError("ErrorDescription", err, ew.ErrorDescription)
+	}
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package wire
 
 import (
+	"github.com/moov-io/base"
 	"log"
 	"strings"
 	"testing"
@@ -25,7 +26,7 @@ func TestMockErrorWire(t *testing.T) {
 
 // TestParseErrorWire parses a known ErrorWire  record string
 func TestParseErrorWire(t *testing.T) {
-	var line = "{1130}1XYZData Error                         "
+	var line = "{1130}EXYZData Error                         "
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 	fwm := new(FEDWireMessage)
@@ -38,8 +39,8 @@ func TestParseErrorWire(t *testing.T) {
 	}
 	record := r.currentFEDWireMessage.ErrorWire
 
-	if record.ErrorCategory != "1" {
-		t.Errorf("ErrorCategory Expected '1' got: %v", record.ErrorCategory)
+	if record.ErrorCategory != "E" {
+		t.Errorf("ErrorCategory Expected 'E' got: %v", record.ErrorCategory)
 	}
 	if record.ErrorCode != "XYZ" {
 		t.Errorf("ErrorCode  Expected 'XYZ' got: %v", record.ErrorCode)
@@ -51,7 +52,7 @@ func TestParseErrorWire(t *testing.T) {
 
 // TestWriteErrorWire writes a ErrorWire record string
 func TestWriteErrorWire(t *testing.T) {
-	var line = "{1130}1XYZData Error                         "
+	var line = "{1130}EXYZData Error                         "
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 	fwm := new(FEDWireMessage)
@@ -67,3 +68,30 @@ func TestWriteErrorWire(t *testing.T) {
 		t.Errorf("\nStrings do not match %s\n %s", line, record.String())
 	}
 }
+
+// TestErrorWireErrorCategoryValid validates ErrorWire ErrorCategory
+func TestErrorWireErrorCategoryValid(t *testing.T) {
+	ew := mockErrorWire()
+	ew.ErrorCategory = "1"
+	if err := ew.Validate(); !base.Match(err, ErrErrorCategory) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestErrorWireErrorDescriptionAlphaNumeric validates ErrorWire ErrorDescription is alphanumeric
+func TestErrorWireErrorDescriptionAlphaNumeric(t *testing.T) {
+	ew := mockErrorWire()
+	ew.ErrorDescription = "®"
+	if err := ew.Validate(); !base.Match(err, ErrNonAlphanumeric) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestErrorWireTagError validates an ErrorWire tag
+func TestErrorWireTagError(t *testing.T) {
+	ew := mockErrorWire()
+	ew.tag = "{9999}"
+	if err := ew.Validate(); !base.Match(err, ErrValidTagForType) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
@@ -31,6 +31,11 @@ func NewExchangeRate() *ExchangeRate {
 	return eRate
 }
 
+// setTag sets the tag of ExchangeRate, which JSON does not hold
+func (eRate *ExchangeRate) setTag() {
+	eRate.tag = TagExchangeRate
+}
+
 // Parse takes the input string and parses the ExchangeRate values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
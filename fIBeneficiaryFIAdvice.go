@@ -30,6 +30,11 @@ func NewFIBeneficiaryFIAdvice() *FIBeneficiaryFIAdvice {
 	return fibfia
 }
 
+// setTag sets the tag of FIBeneficiaryFIAdvice, which JSON does not hold
+func (fibfia *FIBeneficiaryFIAdvice) setTag() {
+	fibfia.tag = TagFIBeneficiaryFIAdvice
+}
+
 // Parse takes the input string and parses the FIBeneficiaryFIAdvice values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
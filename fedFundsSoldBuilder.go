@@ -0,0 +1,28 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// FEDFundsSoldBuilder builds a Fed funds sold (FFS) FEDWireMessage and only sets the tags it may have. The
+// TypeSubType defaults to a basic settlement transfer (1600).
+type FEDFundsSoldBuilder struct {
+	messageBuilder
+}
+
+// NewFEDFundsSoldBuilder returns a new FEDFundsSoldBuilder
+func NewFEDFundsSoldBuilder() *FEDFundsSoldBuilder {
+	return &FEDFundsSoldBuilder{newMessageBuilder(FEDFundsSold, builderTypeSubType(FEDFundsSoldSettlementTransfer))}
+}
+
+// FEDFundsSoldTypeSubType is a TypeSubType {1510} a Fed funds sold may have
+type FEDFundsSoldTypeSubType builderTypeSubType
+
+var (
+	// FEDFundsSoldSettlementTransfer is a basic settlement transfer (1600)
+	FEDFundsSoldSettlementTransfer = FEDFundsSoldTypeSubType{SettlementTransfer, BasicFundsTransfer}
+	// FEDFundsSoldSettlementReversal is a reversal of a settlement transfer (1602)
+	FEDFundsSoldSettlementReversal = FEDFundsSoldTypeSubType{SettlementTransfer, ReversalTransfer}
+	// FEDFundsSoldSettlementReversalPriorDay is a reversal of a prior day settlement transfer (1608)
+	FEDFundsSoldSettlementReversalPriorDay = FEDFundsSoldTypeSubType{SettlementTransfer, ReversalPriorDayTransfer}
+)
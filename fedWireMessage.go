@@ -4,7 +4,10 @@
 
 package wire
 
-import "strings"
+import (
+	"encoding/json"
+	"strings"
+)
 
 // FEDWireMessage is a FedWire Message
 type FEDWireMessage struct {
@@ -131,6 +134,8 @@ type FEDWireMessage struct {
 	RemittanceFreeText *RemittanceFreeText `json:"remittanceFreeText,omitempty"`
 	// ServiceMessage
 	ServiceMessage *ServiceMessage `json:"serviceMessage,omitempty"`
+	// UnknownTags are the tags the Reader did not recognise, when created with PreserveUnknownTags
+	UnknownTags []UnknownTag `json:"unknownTags,omitempty"`
 }
 
 // NewFEDWireMessage returns a new FEDWireMessage
@@ -139,206 +144,141 @@ func NewFEDWireMessage() FEDWireMessage {
 	return fwm
 }
 
-// verify checks basic WIRE rules. Assumes properly parsed records.
-func (fwm *FEDWireMessage) verify() error {
-
-	if err := fwm.isMandatory(); err != nil {
-		return err
-	}
-	if err := fwm.isBusinessCodeValid(); err != nil {
-		return err
-	}
-	if err := fwm.isAmountValid(); err != nil {
-		return err
-	}
-	if err := fwm.otherTransferInformation(); err != nil {
-		return err
-	}
-	if err := fwm.isBeneficiaryIntermediaryFIValid(); err != nil {
-		return err
-	}
-	if err := fwm.isBeneficiaryFIValid(); err != nil {
-		return err
-	}
-	if err := fwm.isOriginatorFIValid(); err != nil {
-		return err
-	}
-	if err := fwm.isInstructingFIValid(); err != nil {
-		return err
-	}
-	if err := fwm.isOriginatorToBeneficiaryValid(); err != nil {
-		return err
-	}
-	if err := fwm.isFIIntermediaryFIValid(); err != nil {
+// UnmarshalJSON reads a FEDWireMessage from JSON, setting the tag of each tag read as JSON does not hold them. A
+// value longer than its field is an error, as it could not be written without cutting it short.
+func (fwm *FEDWireMessage) UnmarshalJSON(data []byte) error {
+	type Alias FEDWireMessage
+	if err := json.Unmarshal(data, (*Alias)(fwm)); err != nil {
 		return err
 	}
-	if err := fwm.isFIIntermediaryFIAdviceValid(); err != nil {
-		return err
-	}
-	if err := fwm.isFIBeneficiaryFIValid(); err != nil {
-		return err
-	}
-	if err := fwm.isFIBeneficiaryFIAdviceValid(); err != nil {
-		return err
-	}
-	if err := fwm.isFIBeneficiaryValid(); err != nil {
-		return err
-	}
-	if err := fwm.isFIBeneficiaryAdviceValid(); err != nil {
-		return err
-	}
-	if err := fwm.isFIPaymentMethodToBeneficiaryValid(); err != nil {
-		return err
+	fwm.setTags()
+	for i := range messageTags {
+		if v := fwm.tagValue(messageTags[i].Tag); v != nil {
+			if err := fieldLengthError(messageTags[i].Name, v); err != nil {
+				return err
+			}
+		}
 	}
-	if err := fwm.isUnstructuredAddendaValid(); err != nil {
-		return err
+	return nil
+}
+
+// verify checks basic WIRE rules. Assumes properly parsed records.
+//
+// verify returns the first error found, use ValidateAll to find every error.
+func (fwm *FEDWireMessage) verify() error {
+	if err := fwm.isMandatory(); err != nil {
+		return firstError(err)
 	}
-	if err := fwm.isRemittanceValid(); err != nil {
-		return err
+	for _, rule := range fwm.rules() {
+		if err := rule(); err != nil {
+			return firstError(err)
+		}
 	}
 	return nil
 }
 
+// rules returns the rules checked by verify, in the order they are checked, once the mandatory tags are
+// known to be defined.
+func (fwm *FEDWireMessage) rules() []func() error {
+	return []func() error{
+		fwm.isBusinessCodeValid,
+		fwm.isAmountValid,
+		fwm.otherTransferInformation,
+		fwm.isBeneficiaryIntermediaryFIValid,
+		fwm.isBeneficiaryFIValid,
+		fwm.isOriginatorFIValid,
+		fwm.isInstructingFIValid,
+		fwm.isOriginatorToBeneficiaryValid,
+		fwm.isFIIntermediaryFIValid,
+		fwm.isFIIntermediaryFIAdviceValid,
+		fwm.isFIBeneficiaryFIValid,
+		fwm.isFIBeneficiaryFIAdviceValid,
+		fwm.isFIBeneficiaryValid,
+		fwm.isFIBeneficiaryAdviceValid,
+		fwm.isFIPaymentMethodToBeneficiaryValid,
+		fwm.isUnstructuredAddendaValid,
+		fwm.isRemittanceValid,
+		fwm.isRemittanceConsistent,
+		fwm.isUnknownTagsValid,
+	}
+}
+
 // isMandatory validates mandatory tags for a FEDWireMessage are defined
 func (fwm *FEDWireMessage) isMandatory() error {
+	var errs ruleErrors
 	if fwm.SenderSupplied == nil {
-		return fieldError("SenderSupplied", ErrFieldRequired)
+		errs.add(fieldError("SenderSupplied", ErrFieldRequired))
 	}
 	if fwm.TypeSubType == nil {
-		return fieldError("TypeSubType", ErrFieldRequired)
+		errs.add(fieldError("TypeSubType", ErrFieldRequired))
 	}
 	if fwm.InputMessageAccountabilityData == nil {
-		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+		errs.add(fieldError("InputMessageAccountabilityData", ErrFieldRequired))
 	}
 	if fwm.Amount == nil {
-		return fieldError("Amount", ErrFieldRequired)
+		errs.add(fieldError("Amount", ErrFieldRequired))
 	}
 	if fwm.SenderDepositoryInstitution == nil {
-		return fieldError("SenderDepositoryInstitution", ErrFieldRequired)
+		errs.add(fieldError("SenderDepositoryInstitution", ErrFieldRequired))
 	}
 	if fwm.ReceiverDepositoryInstitution == nil {
-		return fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
+		errs.add(fieldError("ReceiverDepositoryInstitution", ErrFieldRequired))
 	}
 	if fwm.BusinessFunctionCode == nil {
-		return fieldError("BusinessFunctionCode", ErrFieldRequired)
+		errs.add(fieldError("BusinessFunctionCode", ErrFieldRequired))
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isBusinessCodeValid() error {
+	var errs ruleErrors
 	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
 	case BankTransfer:
-		if err := fwm.isBankTransferValid(); err != nil {
-			return err
-		}
-		if err := fwm.isBankTransferTags(); err != nil {
-			return err
-		}
-		if err := fwm.isInvalidBankTransferTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.isBankTransferValid())
+		errs.add(fwm.isBankTransferTags())
+		errs.add(fwm.isInvalidBankTransferTags())
 	case CustomerTransfer:
-		if err := fwm.isCustomerTransferValid(); err != nil {
-			return err
-		}
-		if err := fwm.isCustomerTransferTags(); err != nil {
-			return err
-		}
-		if err := fwm.isInvalidCustomerTransferTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.isCustomerTransferValid())
+		errs.add(fwm.isCustomerTransferTags())
+		errs.add(fwm.isInvalidCustomerTransferTags())
 	case CustomerTransferPlus:
-		if err := fwm.isCustomerTransferPlusValid(); err != nil {
-			return err
-		}
-		if err := fwm.isCustomerTransferPlusTags(); err != nil {
-			return err
-		}
-		if err := fwm.isInvalidCustomerTransferPlusTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.isCustomerTransferPlusValid())
+		errs.add(fwm.isCustomerTransferPlusTags())
+		errs.add(fwm.isInvalidCustomerTransferPlusTags())
 	case CheckSameDaySettlement:
-		if err := fwm.isCheckSameDaySettlementValid(); err != nil {
-			return err
-		}
-		if err := fwm.isCheckSameDaySettlementTags(); err != nil {
-			return err
-		}
-		if err := fwm.isInvalidTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.isCheckSameDaySettlementValid())
+		errs.add(fwm.isCheckSameDaySettlementTags())
+		errs.add(fwm.isInvalidTags())
 	case DepositSendersAccount:
-		if err := fwm.isDepositSendersAccountValid(); err != nil {
-			return err
-		}
-		if err := fwm.isDepositSendersAccountTags(); err != nil {
-			return err
-		}
-		if err := fwm.isInvalidTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.isDepositSendersAccountValid())
+		errs.add(fwm.isDepositSendersAccountTags())
+		errs.add(fwm.isInvalidTags())
 	case FEDFundsReturned:
-		if err := fwm.isFEDFundsReturnedValid(); err != nil {
-			return err
-		}
-		if err := fwm.isFEDFundsReturnedTags(); err != nil {
-			return err
-		}
-		if err := fwm.isInvalidTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.isFEDFundsReturnedValid())
+		errs.add(fwm.isFEDFundsReturnedTags())
+		errs.add(fwm.isInvalidTags())
 	case FEDFundsSold:
-		if err := fwm.isFEDFundsSoldValid(); err != nil {
-			return err
-		}
-		if err := fwm.isFEDFundsSoldTags(); err != nil {
-			return err
-		}
-		if err := fwm.isInvalidTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.isFEDFundsSoldValid())
+		errs.add(fwm.isFEDFundsSoldTags())
+		errs.add(fwm.isInvalidTags())
 	case DrawDownRequest:
-		if err := fwm.isDrawdownRequestValid(); err != nil {
-			return err
-		}
-		if err := fwm.isDrawdownRequestTags(); err != nil {
-			return err
-		}
-		if err := fwm.isInvalidTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.isDrawdownRequestValid())
+		errs.add(fwm.isDrawdownRequestTags())
+		errs.add(fwm.isInvalidTags())
 	case BankDrawDownRequest:
-		if err := fwm.isBankDrawdownRequestValid(); err != nil {
-			return err
-		}
-		if err := fwm.isBankDrawdownRequestTags(); err != nil {
-			return err
-		}
-		if err := fwm.isInvalidTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.isBankDrawdownRequestValid())
+		errs.add(fwm.isBankDrawdownRequestTags())
+		errs.add(fwm.isInvalidTags())
 	case CustomerCorporateDrawdownRequest:
-		if err := fwm.isCustomerCorporateDrawdownRequestValid(); err != nil {
-			return err
-		}
-		if err := fwm.isCustomerCorporateDrawdownRequestTags(); err != nil {
-			return err
-		}
-		if err := fwm.isInvalidTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.isCustomerCorporateDrawdownRequestValid())
+		errs.add(fwm.isCustomerCorporateDrawdownRequestTags())
+		errs.add(fwm.isInvalidTags())
 	case BFCServiceMessage:
-		if err := fwm.isServiceMessageValid(); err != nil {
-			return err
-		}
-		if err := fwm.isServiceMessageTags(); err != nil {
-			return err
-		}
-		if err := fwm.isInvalidServiceMessageTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.isServiceMessageValid())
+		errs.add(fwm.isServiceMessageTags())
+		errs.add(fwm.isInvalidServiceMessageTags())
 	}
-	return nil
+	return errs.err()
 }
 
 // isBankTransferValid
@@ -366,61 +306,58 @@ func (fwm *FEDWireMessage) isBankTransferTags() error {
 
 // isInvalidBankTransferTags
 func (fwm *FEDWireMessage) isInvalidBankTransferTags() error {
+	var errs ruleErrors
 	if fwm.BusinessFunctionCode != nil {
 		if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
-			return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
+			errs.add(fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
 		}
 	}
 	if fwm.LocalInstrument != nil {
-		return fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument)
+		errs.add(fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument))
 	}
 	if fwm.PaymentNotification != nil {
-		return fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification)
+		errs.add(fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification))
 	}
 	if fwm.Charges != nil {
-		return fieldError("Charges", ErrInvalidProperty, fwm.Charges)
+		errs.add(fieldError("Charges", ErrInvalidProperty, fwm.Charges))
 	}
 	if fwm.InstructedAmount != nil {
-		return fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount)
+		errs.add(fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount))
 	}
 	if fwm.ExchangeRate != nil {
-		return fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate)
+		errs.add(fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate))
 	}
 	if fwm.Beneficiary != nil {
 		if fwm.Beneficiary.Personal.IdentificationCode == "T" {
-			return fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode)
+			errs.add(fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode))
 		}
 	}
 	if fwm.AccountDebitedDrawdown != nil {
-		return fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown)
+		errs.add(fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown))
 	}
 	if fwm.Originator != nil {
 		if fwm.Originator.Personal.IdentificationCode == "T" {
-			return fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode)
+			errs.add(fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode))
 		}
 	}
 	if fwm.OriginatorOptionF != nil {
-		return fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF)
+		errs.add(fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF))
 	}
 	if fwm.AccountCreditedDrawdown != nil {
-		return fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown)
+		errs.add(fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown))
 	}
 	if fwm.FIDrawdownDebitAccountAdvice != nil {
-		return fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice)
+		errs.add(fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice))
 	}
 	if fwm.ServiceMessage != nil {
-		return fieldError("BusinessFunctionCode", ErrInvalidProperty, "ServiceMessage")
+		errs.add(fieldError("BusinessFunctionCode", ErrInvalidProperty, "ServiceMessage"))
 	}
 	if fwm.UnstructuredAddenda != nil {
-		return fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda")
-	}
-	if err := fwm.invalidCoverPaymentTags(); err != nil {
-		return err
-	}
-	if err := fwm.invalidRemittanceTags(); err != nil {
-		return err
+		errs.add(fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda"))
 	}
-	return nil
+	errs.add(fwm.invalidCoverPaymentTags())
+	errs.add(fwm.invalidRemittanceTags())
+	return errs.err()
 }
 
 // isCustomerTransferValid
@@ -446,55 +383,51 @@ func (fwm *FEDWireMessage) isCustomerTransferValid() error {
 
 // isCustomerTransferTags
 func (fwm *FEDWireMessage) isCustomerTransferTags() error {
+	var errs ruleErrors
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		errs.add(fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.Originator == nil && fwm.OriginatorFI == nil {
-		return fieldError("Originator or OriginatorFI", ErrFieldRequired)
-	}
-	if err := fwm.isPreviousMessageIdentifierRequired(); err != nil {
-		return err
+		errs.add(fieldError("Originator or OriginatorFI", ErrFieldRequired))
 	}
-	return nil
+	errs.add(fwm.isPreviousMessageIdentifierRequired())
+	return errs.err()
 }
 
 // isInvalidCustomerTransferTags
 func (fwm *FEDWireMessage) isInvalidCustomerTransferTags() error {
+	var errs ruleErrors
 	// This covers the edit requirement
 	if fwm.BusinessFunctionCode.TransactionTypeCode == "COV" {
-		return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
+		errs.add(fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
 	}
 	if fwm.LocalInstrument != nil {
-		return fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument)
+		errs.add(fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument))
 	}
 	if fwm.PaymentNotification != nil {
-		return fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification)
+		errs.add(fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification))
 	}
 	if fwm.AccountDebitedDrawdown != nil {
-		return fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown)
+		errs.add(fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown))
 	}
 	if fwm.OriginatorOptionF != nil {
-		return fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF)
+		errs.add(fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF))
 	}
 	if fwm.AccountCreditedDrawdown != nil {
-		return fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown)
+		errs.add(fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown))
 	}
 	if fwm.FIDrawdownDebitAccountAdvice != nil {
-		return fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice)
+		errs.add(fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice))
 	}
 	if fwm.ServiceMessage != nil {
-		return fieldError("BusinessFunctionCode", ErrInvalidProperty, "ServiceMessage")
+		errs.add(fieldError("BusinessFunctionCode", ErrInvalidProperty, "ServiceMessage"))
 	}
 	if fwm.UnstructuredAddenda != nil {
-		return fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda")
+		errs.add(fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda"))
 	}
-	if err := fwm.invalidCoverPaymentTags(); err != nil {
-		return err
-	}
-	if err := fwm.invalidRemittanceTags(); err != nil {
-		return err
-	}
-	return nil
+	errs.add(fwm.invalidCoverPaymentTags())
+	errs.add(fwm.invalidRemittanceTags())
+	return errs.err()
 }
 
 // isCustomerTransferPlusValid
@@ -526,91 +459,93 @@ func (fwm *FEDWireMessage) isCustomerTransferPlusValid() error {
 
 // isCustomerTransferPlusTags
 func (fwm *FEDWireMessage) isCustomerTransferPlusTags() error {
+	var errs ruleErrors
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		errs.add(fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.Originator == nil {
-		return fieldError("Originator", ErrFieldRequired)
+		errs.add(fieldError("Originator", ErrFieldRequired))
 	}
-	if err := fwm.isPreviousMessageIdentifierRequired(); err != nil {
-		return err
+	errs.add(fwm.isPreviousMessageIdentifierRequired())
+	if fwm.LocalInstrument == nil {
+		errs.add(fieldError("LocalInstrument", ErrFieldRequired))
+		return errs.err()
 	}
 	switch fwm.LocalInstrument.LocalInstrumentCode {
 	case SequenceBCoverPaymentStructured:
 		if fwm.BeneficiaryReference == nil {
-			return fieldError("Beneficiary Reference", ErrFieldRequired)
+			errs.add(fieldError("BeneficiaryReference", ErrFieldRequired))
 		}
 		if fwm.OrderingCustomer == nil {
-			return fieldError("Ordering Customer", ErrFieldRequired)
+			errs.add(fieldError("OrderingCustomer", ErrFieldRequired))
 		}
 		if fwm.BeneficiaryCustomer == nil {
-			return fieldError("BeneficiaryCustomer", ErrFieldRequired)
+			errs.add(fieldError("BeneficiaryCustomer", ErrFieldRequired))
 		}
 	case ANSIX12format, GeneralXMLformat, ISO20022XMLformat,
 		NarrativeText, STP820format, SWIFTfield70, UNEDIFACTformat:
 		if fwm.UnstructuredAddenda == nil {
-			return fieldError("UnstructuredAddenda", ErrFieldRequired)
+			errs.add(fieldError("UnstructuredAddenda", ErrFieldRequired))
 		}
 	case RelatedRemittanceInformation:
 		if fwm.RelatedRemittance == nil {
-			return fieldError("RelatedRemittance", ErrFieldRequired)
+			errs.add(fieldError("RelatedRemittance", ErrFieldRequired))
 		}
 	case RemittanceInformationStructured:
 		if fwm.RemittanceOriginator == nil {
-			return fieldError("RemittanceOriginator", ErrFieldRequired)
+			errs.add(fieldError("RemittanceOriginator", ErrFieldRequired))
 		}
 		if fwm.RemittanceBeneficiary == nil {
-			return fieldError("RemittanceBeneficiary", ErrFieldRequired)
+			errs.add(fieldError("RemittanceBeneficiary", ErrFieldRequired))
 		}
 		if fwm.PrimaryRemittanceDocument == nil {
-			return fieldError("PrimaryRemittanceDocument", ErrFieldRequired)
+			errs.add(fieldError("PrimaryRemittanceDocument", ErrFieldRequired))
 		}
 		if fwm.ActualAmountPaid == nil {
-			return fieldError("ActualAmountPaid", ErrFieldRequired)
+			errs.add(fieldError("ActualAmountPaid", ErrFieldRequired))
 		}
 	case ProprietaryLocalInstrumentCode:
 		if fwm.LocalInstrument.ProprietaryCode == "" {
-			return fieldError("ProprietaryCode", ErrFieldRequired)
+			errs.add(fieldError("ProprietaryCode", ErrFieldRequired))
 		}
 	}
 	if fwm.LocalInstrument.LocalInstrumentCode != SequenceBCoverPaymentStructured {
-		if err := fwm.invalidCoverPaymentTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.invalidCoverPaymentTags())
 	}
-	return nil
+	return errs.err()
 }
 
 // isInvalidCustomerTransferPlusTags
 func (fwm *FEDWireMessage) isInvalidCustomerTransferPlusTags() error {
+	var errs ruleErrors
 	if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
-		return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
+		errs.add(fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
 	}
 	if fwm.AccountDebitedDrawdown != nil {
-		return fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown)
+		errs.add(fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown))
 	}
 	if fwm.AccountCreditedDrawdown != nil {
-		return fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown)
+		errs.add(fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown))
 	}
 	if fwm.FIReceiverFI != nil {
-		return fieldError("FIReceiverFI", ErrInvalidProperty, fwm.FIReceiverFI)
+		errs.add(fieldError("FIReceiverFI", ErrInvalidProperty, fwm.FIReceiverFI))
 	}
 
 	if fwm.LocalInstrument != nil {
 		if fwm.LocalInstrument.LocalInstrumentCode == SequenceBCoverPaymentStructured {
 			if fwm.Charges != nil {
-				return fieldError("Charges", ErrInvalidProperty, fwm.Charges)
+				errs.add(fieldError("Charges", ErrInvalidProperty, fwm.Charges))
 			}
 			if fwm.InstructedAmount != nil {
-				return fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount)
+				errs.add(fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount))
 			}
 			if fwm.ExchangeRate != nil {
-				return fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate)
+				errs.add(fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate))
 			}
 		}
 	}
 	// ToDo: From the spec - Certain {7xxx} tags & {8xxx} tags may not be permitted depending upon value of {3610}.  I'm not sure how to code this yet
-	return nil
+	return errs.err()
 }
 
 // isCheckSameDaySettlementValid
@@ -709,13 +644,14 @@ func (fwm *FEDWireMessage) isDrawdownRequestValid() error {
 
 // isDrawdownRequestTags
 func (fwm *FEDWireMessage) isDrawdownRequestTags() error {
+	var errs ruleErrors
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		errs.add(fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.Originator == nil {
-		return fieldError("Originator", ErrFieldRequired)
+		errs.add(fieldError("Originator", ErrFieldRequired))
 	}
-	return nil
+	return errs.err()
 }
 
 // isBankDrawdownRequestValid
@@ -734,13 +670,14 @@ func (fwm *FEDWireMessage) isBankDrawdownRequestValid() error {
 
 // isBankDrawdownRequestTags
 func (fwm *FEDWireMessage) isBankDrawdownRequestTags() error {
+	var errs ruleErrors
 	if fwm.AccountDebitedDrawdown == nil {
-		return fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+		errs.add(fieldError("AccountDebitedDrawdown", ErrFieldRequired))
 	}
 	if fwm.AccountCreditedDrawdown == nil {
-		return fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+		errs.add(fieldError("AccountCreditedDrawdown", ErrFieldRequired))
 	}
-	return nil
+	return errs.err()
 }
 
 // isCustomerCorporateDrawdownRequestValid
@@ -759,16 +696,17 @@ func (fwm *FEDWireMessage) isCustomerCorporateDrawdownRequestValid() error {
 
 // isCustomerCorporateDrawdownRequestTags
 func (fwm *FEDWireMessage) isCustomerCorporateDrawdownRequestTags() error {
+	var errs ruleErrors
 	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+		errs.add(fieldError("Beneficiary", ErrFieldRequired))
 	}
 	if fwm.AccountDebitedDrawdown == nil {
-		return fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+		errs.add(fieldError("AccountDebitedDrawdown", ErrFieldRequired))
 	}
 	if fwm.AccountCreditedDrawdown == nil {
-		return fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+		errs.add(fieldError("AccountCreditedDrawdown", ErrFieldRequired))
 	}
-	return nil
+	return errs.err()
 }
 
 // isServiceMessageValid
@@ -801,50 +739,47 @@ func (fwm *FEDWireMessage) isServiceMessageTags() error {
 
 // isInvalidServiceMessageTags
 func (fwm *FEDWireMessage) isInvalidServiceMessageTags() error {
+	var errs ruleErrors
 	// BusinessFunctionCode.TransactionTypeCode (Element 02) is invalid
 	if fwm.BusinessFunctionCode != nil {
 		if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
-			return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
+			errs.add(fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
 		}
 	}
 	if fwm.LocalInstrument != nil {
-		return fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument)
+		errs.add(fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument))
 	}
 	if fwm.PaymentNotification != nil {
-		return fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification)
+		errs.add(fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification))
 	}
 	if fwm.Charges != nil {
-		return fieldError("Charges", ErrInvalidProperty, fwm.Charges)
+		errs.add(fieldError("Charges", ErrInvalidProperty, fwm.Charges))
 	}
 	if fwm.InstructedAmount != nil {
-		return fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount)
+		errs.add(fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount))
 	}
 	if fwm.ExchangeRate != nil {
-		return fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate)
+		errs.add(fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate))
 	}
 	if fwm.Beneficiary != nil {
 		if fwm.Beneficiary.Personal.IdentificationCode == SWIFTBICORBEIANDAccountNumber {
-			return fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode)
+			errs.add(fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode))
 		}
 	}
 	if fwm.Originator != nil {
 		if fwm.Originator.Personal.IdentificationCode == SWIFTBICORBEIANDAccountNumber {
-			return fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode)
+			errs.add(fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode))
 		}
 	}
 	if fwm.OriginatorOptionF != nil {
-		return fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF)
+		errs.add(fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF))
 	}
 	if fwm.UnstructuredAddenda != nil {
-		return fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda")
+		errs.add(fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda"))
 	}
-	if err := fwm.invalidCoverPaymentTags(); err != nil {
-		return err
-	}
-	if err := fwm.invalidRemittanceTags(); err != nil {
-		return err
-	}
-	return nil
+	errs.add(fwm.invalidCoverPaymentTags())
+	errs.add(fwm.invalidRemittanceTags())
+	return errs.err()
 }
 
 // isPreviousMessageIdentifierRequired
@@ -865,164 +800,159 @@ func (fwm *FEDWireMessage) isPreviousMessageIdentifierRequired() error {
 // BusinessFunctionCode, create function isInvalidBusinessFunctionCodeTag() with the specific invalid tags for that
 // BusinessFunctionCode (e.g. isInvalidBankTransferTags)
 func (fwm *FEDWireMessage) isInvalidTags() error {
+	var errs ruleErrors
 	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
 	case CheckSameDaySettlement, DepositSendersAccount, FEDFundsReturned, FEDFundsSold:
 		if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
-			return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
+			errs.add(fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
 		}
 		if fwm.LocalInstrument != nil {
-			return fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument)
+			errs.add(fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument))
 		}
 		if fwm.PaymentNotification != nil {
-			return fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification)
+			errs.add(fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification))
 		}
 		if fwm.Charges != nil {
-			return fieldError("Charges", ErrInvalidProperty, fwm.Charges)
+			errs.add(fieldError("Charges", ErrInvalidProperty, fwm.Charges))
 		}
 		if fwm.InstructedAmount != nil {
-			return fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount)
+			errs.add(fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount))
 		}
 		if fwm.ExchangeRate != nil {
-			return fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate)
+			errs.add(fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate))
 		}
-		if fwm.Beneficiary.Personal.IdentificationCode == "T" {
-			return fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode)
+		if fwm.Beneficiary != nil && fwm.Beneficiary.Personal.IdentificationCode == "T" {
+			errs.add(fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode))
 		}
 		if fwm.AccountDebitedDrawdown != nil {
-			return fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown)
+			errs.add(fieldError("AccountDebitedDrawdown", ErrInvalidProperty, fwm.AccountDebitedDrawdown))
 		}
-		if fwm.Originator.Personal.IdentificationCode == "T" {
-			return fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode)
+		if fwm.Originator != nil && fwm.Originator.Personal.IdentificationCode == "T" {
+			errs.add(fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode))
 		}
 		if fwm.OriginatorOptionF != nil {
-			return fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF)
+			errs.add(fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF))
 		}
 		if fwm.AccountCreditedDrawdown != nil {
-			return fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown)
+			errs.add(fieldError("AccountCreditedDrawdown", ErrInvalidProperty, fwm.AccountCreditedDrawdown))
 		}
 		if fwm.FIDrawdownDebitAccountAdvice != nil {
-			return fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice)
+			errs.add(fieldError("FIDrawdownDebitAccountAdvice", ErrInvalidProperty, fwm.FIDrawdownDebitAccountAdvice))
 		}
 		if fwm.ServiceMessage != nil {
-			return fieldError("BusinessFunctionCode", ErrInvalidProperty, "ServiceMessage")
+			errs.add(fieldError("BusinessFunctionCode", ErrInvalidProperty, "ServiceMessage"))
 		}
 		if fwm.UnstructuredAddenda != nil {
-			return fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda")
-		}
-		if err := fwm.invalidCoverPaymentTags(); err != nil {
-			return err
+			errs.add(fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda"))
 		}
+		errs.add(fwm.invalidCoverPaymentTags())
 
-		if err := fwm.invalidRemittanceTags(); err != nil {
-			return err
-		}
+		errs.add(fwm.invalidRemittanceTags())
 	case DrawDownRequest, BankDrawDownRequest, CustomerCorporateDrawdownRequest:
 		if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
-			return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
+			errs.add(fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode))
 		}
 		if fwm.LocalInstrument != nil {
-			return fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument)
+			errs.add(fieldError("LocalInstrument", ErrInvalidProperty, fwm.LocalInstrument))
 		}
 		if fwm.PaymentNotification != nil {
-			return fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification)
+			errs.add(fieldError("PaymentNotification", ErrInvalidProperty, fwm.PaymentNotification))
 		}
 		if fwm.Charges != nil {
-			return fieldError("Charges", ErrInvalidProperty, fwm.Charges)
+			errs.add(fieldError("Charges", ErrInvalidProperty, fwm.Charges))
 		}
 		if fwm.InstructedAmount != nil {
-			return fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount)
+			errs.add(fieldError("InstructedAmount", ErrInvalidProperty, fwm.InstructedAmount))
 		}
 		if fwm.ExchangeRate != nil {
-			return fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate)
+			errs.add(fieldError("ExchangeRate", ErrInvalidProperty, fwm.ExchangeRate))
 		}
-		if fwm.Beneficiary.Personal.IdentificationCode == "T" {
-			return fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode)
+		if fwm.Beneficiary != nil && fwm.Beneficiary.Personal.IdentificationCode == "T" {
+			errs.add(fieldError("Beneficiary.Personal.IdentificationCode", ErrInvalidProperty, fwm.Beneficiary.Personal.IdentificationCode))
 		}
-		if fwm.Originator.Personal.IdentificationCode == "T" {
-			return fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode)
+		if fwm.Originator != nil && fwm.Originator.Personal.IdentificationCode == "T" {
+			errs.add(fieldError("Originator.Personal.IdentificationCode", ErrInvalidProperty, fwm.Originator.Personal.IdentificationCode))
 		}
 		if fwm.OriginatorOptionF != nil {
-			return fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF)
+			errs.add(fieldError("OriginatorOptionF", ErrInvalidProperty, fwm.OriginatorOptionF))
 		}
 		if fwm.ServiceMessage != nil {
-			return fieldError("BusinessFunctionCode", ErrInvalidProperty, "ServiceMessage")
+			errs.add(fieldError("BusinessFunctionCode", ErrInvalidProperty, "ServiceMessage"))
 		}
 		if fwm.UnstructuredAddenda != nil {
-			return fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda")
-		}
-		if err := fwm.invalidCoverPaymentTags(); err != nil {
-			return err
-		}
-		if err := fwm.invalidRemittanceTags(); err != nil {
-			return err
+			errs.add(fieldError("BusinessFunctionCode", ErrInvalidProperty, "Unstructured Addenda"))
 		}
+		errs.add(fwm.invalidCoverPaymentTags())
+		errs.add(fwm.invalidRemittanceTags())
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) invalidRemittanceTags() error {
+	var errs ruleErrors
 	if fwm.RelatedRemittance != nil {
-		return fieldError("RelatedRemittance", ErrInvalidProperty, "RelatedRemittance")
+		errs.add(fieldError("RelatedRemittance", ErrInvalidProperty, "RelatedRemittance"))
 	}
 	if fwm.RemittanceOriginator != nil {
-		return fieldError("RemittanceOriginator", ErrInvalidProperty, "RemittanceOriginator")
+		errs.add(fieldError("RemittanceOriginator", ErrInvalidProperty, "RemittanceOriginator"))
 	}
 	if fwm.RemittanceBeneficiary != nil {
-		return fieldError("RemittanceBeneficiary", ErrInvalidProperty, "RemittanceBeneficiary")
+		errs.add(fieldError("RemittanceBeneficiary", ErrInvalidProperty, "RemittanceBeneficiary"))
 	}
 	if fwm.PrimaryRemittanceDocument != nil {
-		return fieldError("PrimaryRemittanceDocument", ErrInvalidProperty, "PrimaryRemittanceDocument")
+		errs.add(fieldError("PrimaryRemittanceDocument", ErrInvalidProperty, "PrimaryRemittanceDocument"))
 	}
 	if fwm.ActualAmountPaid != nil {
-		return fieldError("ActualAmountPaid", ErrInvalidProperty, "ActualAmountPaid")
+		errs.add(fieldError("ActualAmountPaid", ErrInvalidProperty, "ActualAmountPaid"))
 	}
 	if fwm.GrossAmountRemittanceDocument != nil {
-		return fieldError("GrossAmountRemittanceDocument", ErrInvalidProperty, "GrossAmountRemittanceDocument")
+		errs.add(fieldError("GrossAmountRemittanceDocument", ErrInvalidProperty, "GrossAmountRemittanceDocument"))
 	}
 	if fwm.AmountNegotiatedDiscount != nil {
-		return fieldError("AmountNegotiatedDiscount", ErrInvalidProperty, "AmountNegotiatedDiscount")
+		errs.add(fieldError("AmountNegotiatedDiscount", ErrInvalidProperty, "AmountNegotiatedDiscount"))
 	}
 	if fwm.Adjustment != nil {
-		return fieldError("Adjustment", ErrInvalidProperty, "Adjustment")
+		errs.add(fieldError("Adjustment", ErrInvalidProperty, "Adjustment"))
 	}
 	if fwm.DateRemittanceDocument != nil {
-		return fieldError("DateRemittanceDocument", ErrInvalidProperty, "DateRemittanceDocument")
+		errs.add(fieldError("DateRemittanceDocument", ErrInvalidProperty, "DateRemittanceDocument"))
 	}
 	if fwm.SecondaryRemittanceDocument != nil {
-		return fieldError("SecondaryRemittanceDocument", ErrInvalidProperty, "SecondaryRemittanceDocument")
+		errs.add(fieldError("SecondaryRemittanceDocument", ErrInvalidProperty, "SecondaryRemittanceDocument"))
 	}
 	if fwm.RemittanceFreeText != nil {
-		return fieldError("RemittanceFreeText", ErrInvalidProperty, "RemittanceFreeText")
+		errs.add(fieldError("RemittanceFreeText", ErrInvalidProperty, "RemittanceFreeText"))
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) invalidCoverPaymentTags() error {
+	var errs ruleErrors
 	if fwm.CurrencyInstructedAmount != nil {
-		return fieldError("CurrencyInstructedAmount ", ErrInvalidProperty, fwm.CurrencyInstructedAmount)
+		errs.add(fieldError("CurrencyInstructedAmount", ErrInvalidProperty, fwm.CurrencyInstructedAmount))
 	}
 	if fwm.OrderingCustomer != nil {
-		return fieldError("OrderingCustomer", ErrInvalidProperty, fwm.OrderingCustomer)
+		errs.add(fieldError("OrderingCustomer", ErrInvalidProperty, fwm.OrderingCustomer))
 	}
 	if fwm.OrderingInstitution != nil {
-		return fieldError("OrderingInstitution", ErrInvalidProperty, fwm.OrderingInstitution)
+		errs.add(fieldError("OrderingInstitution", ErrInvalidProperty, fwm.OrderingInstitution))
 	}
 	if fwm.IntermediaryInstitution != nil {
-		return fieldError("IntermediaryInstitution", ErrInvalidProperty, fwm.IntermediaryInstitution)
+		errs.add(fieldError("IntermediaryInstitution", ErrInvalidProperty, fwm.IntermediaryInstitution))
 	}
 	if fwm.InstitutionAccount != nil {
-		return fieldError("InstitutionAccount", ErrInvalidProperty, fwm.InstitutionAccount)
+		errs.add(fieldError("InstitutionAccount", ErrInvalidProperty, fwm.InstitutionAccount))
 	}
 	if fwm.BeneficiaryCustomer != nil {
-		return fieldError("BeneficiaryCustomer", ErrInvalidProperty, fwm.BeneficiaryCustomer)
+		errs.add(fieldError("BeneficiaryCustomer", ErrInvalidProperty, fwm.BeneficiaryCustomer))
 	}
 	if fwm.Remittance != nil {
-		return fieldError("Remittance", ErrInvalidProperty, fwm.Remittance)
+		errs.add(fieldError("Remittance", ErrInvalidProperty, fwm.Remittance))
 	}
 	if fwm.SenderToReceiver != nil {
-		return fieldError("SenderToReceiver", ErrInvalidProperty, fwm.SenderToReceiver)
+		errs.add(fieldError("SenderToReceiver", ErrInvalidProperty, fwm.SenderToReceiver))
 	}
-	return nil
+	return errs.err()
 }
 
 // SetSenderSupplied appends a SenderSupplied to the FEDWireMessage
@@ -1685,30 +1615,32 @@ func (fwm *FEDWireMessage) isInstructedAmountValid() error {
 }
 
 func (fwm *FEDWireMessage) isExchangeRateValid() error {
+	var errs ruleErrors
 	if fwm.ExchangeRate != nil {
 		if fwm.InstructedAmount == nil {
-			return fieldError("InstructedAmount", ErrFieldRequired)
+			errs.add(fieldError("InstructedAmount", ErrFieldRequired))
 		}
 		if fwm.LocalInstrument != nil {
 			if fwm.LocalInstrument.LocalInstrumentCode == SequenceBCoverPaymentStructured {
-				return NewErrInvalidPropertyForProperty("LocalInstrumentCode",
-					fwm.LocalInstrument.LocalInstrumentCode, "ExchangeRate", fwm.ExchangeRate.ExchangeRate)
+				errs.add(NewErrInvalidPropertyForProperty("LocalInstrumentCode",
+					fwm.LocalInstrument.LocalInstrumentCode, "ExchangeRate", fwm.ExchangeRate.ExchangeRate))
 			}
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isBeneficiaryIntermediaryFIValid() error {
+	var errs ruleErrors
 	if fwm.BeneficiaryIntermediaryFI != nil {
 		if fwm.BeneficiaryFI == nil {
-			return fieldError("BeneficiaryFI", ErrFieldRequired)
+			errs.add(fieldError("BeneficiaryFI", ErrFieldRequired))
 		}
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			errs.add(fieldError("Beneficiary", ErrFieldRequired))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isBeneficiaryFIValid() error {
@@ -1719,101 +1651,110 @@ func (fwm *FEDWireMessage) isBeneficiaryFIValid() error {
 }
 
 func (fwm *FEDWireMessage) isOriginatorFIValid() error {
+	var errs ruleErrors
 	if fwm.OriginatorFI != nil {
 		if fwm.Originator == nil {
-			return fieldError("Originator", ErrFieldRequired)
+			errs.add(fieldError("Originator", ErrFieldRequired))
 		}
 		if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus {
 			if fwm.OriginatorOptionF == nil {
-				return fieldError("OriginatorOptionF", ErrFieldRequired)
+				errs.add(fieldError("OriginatorOptionF", ErrFieldRequired))
 			}
 		}
 
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isInstructingFIValid() error {
+	var errs ruleErrors
 	if fwm.InstructingFI != nil {
 		if fwm.Originator == nil {
-			return fieldError("Originator", ErrFieldRequired)
+			errs.add(fieldError("Originator", ErrFieldRequired))
 		}
 		if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus {
 			if fwm.OriginatorOptionF == nil {
-				return fieldError("OriginatorOptionF", ErrFieldRequired)
+				errs.add(fieldError("OriginatorOptionF", ErrFieldRequired))
 			}
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isOriginatorToBeneficiaryValid() error {
+	var errs ruleErrors
 	if fwm.OriginatorToBeneficiary != nil {
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			errs.add(fieldError("Beneficiary", ErrFieldRequired))
 		}
 		if fwm.Originator == nil {
-			return fieldError("Originator", ErrFieldRequired)
+			errs.add(fieldError("Originator", ErrFieldRequired))
 		}
 		if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus {
 			if fwm.OriginatorOptionF == nil {
-				return fieldError("OriginatorOptionF", ErrFieldRequired)
+				errs.add(fieldError("OriginatorOptionF", ErrFieldRequired))
 			}
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isFIIntermediaryFIValid() error {
-	if fwm.BeneficiaryIntermediaryFI == nil {
-		return fieldError("BeneficiaryIntermediaryFI", ErrFieldRequired)
-	}
-	if fwm.BeneficiaryFI == nil {
-		return fieldError("BeneficiaryFI", ErrFieldRequired)
-	}
-	if fwm.Beneficiary == nil {
-		return fieldError("Beneficiary", ErrFieldRequired)
+	var errs ruleErrors
+	if fwm.FIIntermediaryFI != nil {
+		if fwm.BeneficiaryIntermediaryFI == nil {
+			errs.add(fieldError("BeneficiaryIntermediaryFI", ErrFieldRequired))
+		}
+		if fwm.BeneficiaryFI == nil {
+			errs.add(fieldError("BeneficiaryFI", ErrFieldRequired))
+		}
+		if fwm.Beneficiary == nil {
+			errs.add(fieldError("Beneficiary", ErrFieldRequired))
+		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isFIIntermediaryFIAdviceValid() error {
+	var errs ruleErrors
 	if fwm.FIIntermediaryFIAdvice != nil {
 		if fwm.BeneficiaryIntermediaryFI == nil {
-			return fieldError("BeneficiaryIntermediaryFI", ErrFieldRequired)
+			errs.add(fieldError("BeneficiaryIntermediaryFI", ErrFieldRequired))
 		}
 		if fwm.BeneficiaryFI == nil {
-			return fieldError("BeneficiaryFI", ErrFieldRequired)
+			errs.add(fieldError("BeneficiaryFI", ErrFieldRequired))
 		}
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			errs.add(fieldError("Beneficiary", ErrFieldRequired))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isFIBeneficiaryFIValid() error {
+	var errs ruleErrors
 	if fwm.FIBeneficiaryFI != nil {
 		if fwm.BeneficiaryFI == nil {
-			return fieldError("BeneficiaryFI", ErrFieldRequired)
+			errs.add(fieldError("BeneficiaryFI", ErrFieldRequired))
 		}
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			errs.add(fieldError("Beneficiary", ErrFieldRequired))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isFIBeneficiaryFIAdviceValid() error {
+	var errs ruleErrors
 	if fwm.FIBeneficiaryFIAdvice != nil {
 		if fwm.BeneficiaryFI == nil {
-			return fieldError("BeneficiaryFI", ErrFieldRequired)
+			errs.add(fieldError("BeneficiaryFI", ErrFieldRequired))
 		}
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			errs.add(fieldError("Beneficiary", ErrFieldRequired))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isFIBeneficiaryValid() error {
@@ -1834,15 +1775,16 @@ func (fwm *FEDWireMessage) isFIBeneficiaryAdviceValid() error {
 }
 
 func (fwm *FEDWireMessage) isFIPaymentMethodToBeneficiaryValid() error {
+	var errs ruleErrors
 	if fwm.FIPaymentMethodToBeneficiary != nil {
 		if fwm.FIBeneficiary == nil {
-			return fieldError("FIBeneficiary", ErrFieldRequired)
+			errs.add(fieldError("FIBeneficiary", ErrFieldRequired))
 		}
 		if fwm.Beneficiary == nil {
-			return fieldError("Beneficiary", ErrFieldRequired)
+			errs.add(fieldError("Beneficiary", ErrFieldRequired))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isUnstructuredAddendaValid() error {
@@ -1985,70 +1927,44 @@ func (fwm *FEDWireMessage) isRemittanceFreeTextValid() error {
 }
 
 func (fwm *FEDWireMessage) otherTransferInformation() error {
-	if err := fwm.isPreviousMessageIdentifierValid(); err != nil {
-		return err
-	}
-	if err := fwm.isLocalInstrumentCodeValid(); err != nil {
-		return err
-	}
-	if err := fwm.isChargesValid(); err != nil {
-		return err
-	}
-	if err := fwm.isInstructedAmountValid(); err != nil {
-		return err
-	}
+	var errs ruleErrors
+	errs.add(fwm.isPreviousMessageIdentifierValid())
+	errs.add(fwm.isLocalInstrumentCodeValid())
+	errs.add(fwm.isChargesValid())
+	errs.add(fwm.isInstructedAmountValid())
 
-	if err := fwm.isExchangeRateValid(); err != nil {
-		return err
-	}
-	return nil
+	errs.add(fwm.isExchangeRateValid())
+	return errs.err()
 }
 
 func (fwm *FEDWireMessage) isRemittanceValid() error {
+	var errs ruleErrors
 	if fwm.RelatedRemittance != nil {
-		if err := fwm.isRelatedRemittanceValid(); err != nil {
-			return err
-		}
+		errs.add(fwm.isRelatedRemittanceValid())
 	}
 	if fwm.RemittanceOriginator != nil {
-		if err := fwm.isRemittanceOriginatorValid(); err != nil {
-			return err
-		}
+		errs.add(fwm.isRemittanceOriginatorValid())
 	}
 	if fwm.RemittanceBeneficiary != nil {
-		if err := fwm.isRemittanceBeneficiaryValid(); err != nil {
-			return err
-		}
+		errs.add(fwm.isRemittanceBeneficiaryValid())
 	}
 	if fwm.PrimaryRemittanceDocument != nil {
-		if err := fwm.isPrimaryRemittanceDocumentValid(); err != nil {
-			return err
-		}
+		errs.add(fwm.isPrimaryRemittanceDocumentValid())
 	}
 	if fwm.ActualAmountPaid != nil {
-		if err := fwm.isActualAmountPaidValid(); err != nil {
-			return err
-		}
+		errs.add(fwm.isActualAmountPaidValid())
 	}
 	if fwm.GrossAmountRemittanceDocument != nil {
-		if err := fwm.isGrossAmountRemittanceDocumentValid(); err != nil {
-			return err
-		}
+		errs.add(fwm.isGrossAmountRemittanceDocumentValid())
 	}
 	if fwm.Adjustment != nil {
-		if err := fwm.isAdjustmentValid(); err != nil {
-			return err
-		}
+		errs.add(fwm.isAdjustmentValid())
 	}
 	if fwm.DateRemittanceDocument != nil {
-		if err := fwm.isDateRemittanceDocumentValid(); err != nil {
-			return err
-		}
+		errs.add(fwm.isDateRemittanceDocumentValid())
 	}
 	if fwm.RemittanceFreeText != nil {
-		if err := fwm.isRemittanceFreeTextValid(); err != nil {
-			return err
-		}
+		errs.add(fwm.isRemittanceFreeTextValid())
 	}
-	return nil
+	return errs.err()
 }
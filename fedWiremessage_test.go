@@ -317,32 +317,37 @@ func TestNewFEDWireMessage_isOriginatorToBeneficiaryValid(t *testing.T) {
 func TestFEDWireMessage_isFIIntermediaryFIValid(t *testing.T) {
 	file := NewFile()
 	fwm := mockCustomerTransferData()
-	fiifi := mockFIIntermediaryFI()
-	fwm.SetFIIntermediaryFI(fiifi)
 	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
 	file.AddFEDWireMessage(fwm)
-	// BeneficiaryIntermediaryFI required field check
+	// Without FIIntermediaryFI nothing is required
 	if err := fwm.isFIIntermediaryFIValid(); err != nil {
-		if !base.Match(err, ErrFieldRequired) {
-			t.Errorf("%T: %s", err, err)
+		t.Errorf("%T: %s", err, err)
+	}
+	fiifi := mockFIIntermediaryFI()
+	fwm.SetFIIntermediaryFI(fiifi)
+	// BeneficiaryIntermediaryFI, BeneficiaryFI and Beneficiary required field check
+	err := fwm.isFIIntermediaryFIValid()
+	errs, ok := err.(ruleErrors)
+	if !ok || len(errs) != 3 {
+		t.Fatalf("%T: %s", err, err)
+	}
+	for i, name := range []string{"BeneficiaryIntermediaryFI", "BeneficiaryFI", "Beneficiary"} {
+		if fe, ok := errs[i].(*FieldError); !ok || fe.FieldName != name || !base.Match(fe, ErrFieldRequired) {
+			t.Errorf("%T: %s", errs[i], errs[i])
 		}
 	}
 	bifi := mockBeneficiaryIntermediaryFI()
 	fwm.SetBeneficiaryIntermediaryFI(bifi)
-	// BeneficiaryFI required field check
-	if err := fwm.isFIIntermediaryFIValid(); err != nil {
-		if !base.Match(err, ErrFieldRequired) {
-			t.Errorf("%T: %s", err, err)
-		}
-	}
 	bfi := mockBeneficiaryFI()
 	fwm.SetBeneficiaryFI(bfi)
-
 	// Beneficiary required field check
+	if err := fwm.isFIIntermediaryFIValid(); !base.Match(err, ErrFieldRequired) {
+		t.Errorf("%T: %s", err, err)
+	}
+	ben := mockBeneficiary()
+	fwm.SetBeneficiary(ben)
 	if err := fwm.isFIIntermediaryFIValid(); err != nil {
-		if !base.Match(err, ErrFieldRequired) {
-			t.Errorf("%T: %s", err, err)
-		}
+		t.Errorf("%T: %s", err, err)
 	}
 }
 
@@ -30,6 +30,11 @@ func NewFIAdditionalFIToFI() *FIAdditionalFIToFI {
 	return fifi
 }
 
+// setTag sets the tag of FIAdditionalFIToFI, which JSON does not hold
+func (fifi *FIAdditionalFIToFI) setTag() {
+	fifi.tag = TagFIAdditionalFIToFI
+}
+
 // Parse takes the input string and parses the FIAdditionalFIToFI values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -30,6 +30,11 @@ func NewFIBeneficiary() *FIBeneficiary {
 	return fib
 }
 
+// setTag sets the tag of FIBeneficiary, which JSON does not hold
+func (fib *FIBeneficiary) setTag() {
+	fib.tag = TagFIBeneficiary
+}
+
 // Parse takes the input string and parses the FIBeneficiary values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
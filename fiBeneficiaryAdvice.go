@@ -30,6 +30,11 @@ func NewFIBeneficiaryAdvice() *FIBeneficiaryAdvice {
 	return fiba
 }
 
+// setTag sets the tag of FIBeneficiaryAdvice, which JSON does not hold
+func (fiba *FIBeneficiaryAdvice) setTag() {
+	fiba.tag = TagFIBeneficiaryAdvice
+}
+
 // Parse takes the input string and parses the FIBeneficiaryAdvice values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
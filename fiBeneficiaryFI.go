@@ -30,6 +30,11 @@ func NewFIBeneficiaryFI() *FIBeneficiaryFI {
 	return fibfi
 }
 
+// setTag sets the tag of FIBeneficiaryFI, which JSON does not hold
+func (fibfi *FIBeneficiaryFI) setTag() {
+	fibfi.tag = TagFIBeneficiaryFI
+}
+
 // Parse takes the input string and parses the FIBeneficiaryFI values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
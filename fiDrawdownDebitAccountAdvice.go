@@ -30,6 +30,11 @@ func NewFIDrawdownDebitAccountAdvice() *FIDrawdownDebitAccountAdvice {
 	return debitDDAdvice
 }
 
+// setTag sets the tag of FIDrawdownDebitAccountAdvice, which JSON does not hold
+func (debitDDAdvice *FIDrawdownDebitAccountAdvice) setTag() {
+	debitDDAdvice.tag = TagFIDrawdownDebitAccountAdvice
+}
+
 // Parse takes the input string and parses the FIDrawdownDebitAccountAdvice values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -30,6 +30,11 @@ func NewFIIntermediaryFI() *FIIntermediaryFI {
 	return fiifi
 }
 
+// setTag sets the tag of FIIntermediaryFI, which JSON does not hold
+func (fiifi *FIIntermediaryFI) setTag() {
+	fiifi.tag = TagFIIntermediaryFI
+}
+
 // Parse takes the input string and parses the FIIntermediaryFI values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
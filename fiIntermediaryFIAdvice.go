@@ -30,6 +30,11 @@ func NewFIIntermediaryFIAdvice() *FIIntermediaryFIAdvice {
 	return fiifia
 }
 
+// setTag sets the tag of FIIntermediaryFIAdvice, which JSON does not hold
+func (fiifia *FIIntermediaryFIAdvice) setTag() {
+	fiifia.tag = TagFIIntermediaryFIAdvice
+}
+
 // Parse takes the input string and parses the FIIntermediaryFIAdvice values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
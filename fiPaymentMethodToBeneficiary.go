@@ -33,6 +33,11 @@ func NewFIPaymentMethodToBeneficiary() *FIPaymentMethodToBeneficiary {
 	return pm
 }
 
+// setTag sets the tag of FIPaymentMethodToBeneficiary, which JSON does not hold
+func (pm *FIPaymentMethodToBeneficiary) setTag() {
+	pm.tag = TagFIPaymentMethodToBeneficiary
+}
+
 // Parse takes the input string and parses the FIPaymentMethodToBeneficiary values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -30,6 +30,11 @@ func NewFIReceiverFI() *FIReceiverFI {
 	return firfi
 }
 
+// setTag sets the tag of FIReceiverFI, which JSON does not hold
+func (firfi *FIReceiverFI) setTag() {
+	firfi.tag = TagFIReceiverFI
+}
+
 // Parse takes the input string and parses the FIReceiverFI values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -44,7 +49,7 @@ func (firfi *FIReceiverFI) Parse(record string) error {
 	firfi.FIToFI.LineThree = firfi.parseStringField(record[69:102])
 	firfi.FIToFI.LineFour = firfi.parseStringField(record[102:135])
 	firfi.FIToFI.LineFive = firfi.parseStringField(record[135:168])
-	firfi.FIToFI.LineSix = firfi.parseStringField(record[174:201])
+	firfi.FIToFI.LineSix = firfi.parseStringField(record[168:201])
 	return nil
 }
 
@@ -7,6 +7,7 @@ package wire
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
@@ -42,6 +43,62 @@ var (
 	ErrValidDate = errors.New("is an invalid date format")
 	// ErrInvalidProperty is returned for an invalid type property
 	ErrInvalidProperty = errors.New("is an invalid property")
+	// ErrRoutingNumber is returned for a routing number which is not nine digits with a valid check digit
+	ErrRoutingNumber = errors.New("is an invalid routing number")
+	// ErrNotFedwireParticipant is returned for a routing number which the RoutingDirectory does not list as an
+	// active Fedwire participant
+	ErrNotFedwireParticipant = errors.New("is not an active Fedwire participant")
+	// ErrBIC is returned for a SWIFT Bank Identifier Code (BIC) which is not four letters, a country code, a two
+	// character location and an optional three character branch
+	ErrBIC = errors.New("is an invalid BIC")
+	// ErrIBAN is returned for an International Bank Account Number (IBAN) which fails its mod-97 check
+	ErrIBAN = errors.New("is an invalid IBAN")
+
+	// ErrBusinessDay is returned for a cycle date which is not a Fedwire business day
+	ErrBusinessDay = errors.New("is not a business day")
+	// ErrCycleDateNotOpen is returned for a cycle date which the Fedwire Funds Service has not opened
+	ErrCycleDateNotOpen = errors.New("is not open")
+	// ErrCutoffHour is returned for a business function code received after its cutoff hour
+	ErrCutoffHour = errors.New("is after its cutoff hour")
+	// ErrInputSequenceNumberExhausted is returned when every input sequence number of an input source and cycle
+	// date has been allocated
+	ErrInputSequenceNumberExhausted = errors.New("every input sequence number has been allocated")
+	// ErrPriorCycleDate is returned for a cycle date before the latest cycle date allocated for an input source
+	ErrPriorCycleDate = errors.New("is before the latest allocated cycle date")
+
+	// ErrValidTime is returned for an invalid HHMM time
+	ErrValidTime = errors.New("is an invalid time")
+
+	// Structured remittance tags {8250} - {8750}
+
+	// ErrRemittanceAmountPaid is returned when ActualAmountPaid {8450} is not GrossAmountRemittanceDocument {8500}
+	// less AmountNegotiatedDiscount {8550}, plus a credit or minus a debit Adjustment {8600}
+	ErrRemittanceAmountPaid = errors.New("is not the gross amount less discount and adjustment of")
+	// ErrRemittanceCurrency is returned when the remittance amounts are in different currencies
+	ErrRemittanceCurrency = errors.New("is not the currency code of")
+	// ErrRemittanceExceedsAmount is returned when ActualAmountPaid {8450} is more than the Amount {2000}
+	ErrRemittanceExceedsAmount = errors.New("exceeds the amount of")
+	// ErrRemittanceDocument is returned when SecondaryRemittanceDocument {8700} repeats the type code and
+	// identification number of PrimaryRemittanceDocument {8400}
+	ErrRemittanceDocument = errors.New("repeats the document")
+	// ErrRemittanceDocumentDate is returned when DateRemittanceDocument {8650} is sent without the
+	// PrimaryRemittanceDocument {8400} it dates
+	ErrRemittanceDocumentDate = errors.New("dates a document which is not identified")
+	// ErrRemittanceDocumentAdjustment is returned when a PrimaryRemittanceDocument {8400} which is a credit or
+	// debit note related to a financial adjustment has no Adjustment {8600} in the same direction
+	ErrRemittanceDocumentAdjustment = errors.New("requires an Adjustment with CreditDebitIndicator")
+	// ErrRemittanceStructured is returned when RelatedRemittance {8250} is sent with structured remittance tags
+	ErrRemittanceStructured = errors.New("cannot be sent with structured remittance")
+
+	// MessageDisposition Tag {1100}
+
+	// ErrMessageStatusIndicator is returned for an invalid MessageStatusIndicator
+	ErrMessageStatusIndicator = errors.New("is an invalid message status indicator")
+
+	// ErrorWire Tag {1130}
+
+	// ErrErrorCategory is returned for an invalid ErrorCategory
+	ErrErrorCategory = errors.New("is an invalid error category")
 
 	// SenderSupplied Tag {1500}
 
@@ -203,6 +260,46 @@ func (e ErrInvalidPropertyForProperty) Error() string {
 	return e.Message
 }
 
+// ErrRemittanceConflict is the error given when a structured remittance tag disagrees with other tags of the
+// FEDWireMessage
+type ErrRemittanceConflict struct {
+	Message string
+	// Property is the name of the tag in conflict, e.g. ActualAmountPaid
+	Property      string
+	PropertyValue string
+	// ConflictingProperties are the names of the tags Property disagrees with
+	ConflictingProperties []string
+	ConflictingValue      string
+	// Err is the rule which was broken, e.g. ErrRemittanceCurrency
+	Err error
+}
+
+// NewErrRemittanceConflict creates a new error of the ErrRemittanceConflict type
+func NewErrRemittanceConflict(property, propertyValue string, conflictingProperties []string, conflictingValue string, err error) ErrRemittanceConflict {
+	names := make([]string, len(conflictingProperties))
+	for i := range conflictingProperties {
+		names[i] = strings.TrimSpace(conflictingProperties[i] + " " + tagForName(conflictingProperties[i]))
+	}
+	return ErrRemittanceConflict{
+		Message: strings.TrimSpace(fmt.Sprintf("%v conflicts with %v: %v %v %v",
+			strings.TrimSpace(property+" "+tagForName(property)), strings.Join(names, ", "), propertyValue, err, conflictingValue)),
+		Property:              property,
+		PropertyValue:         propertyValue,
+		ConflictingProperties: conflictingProperties,
+		ConflictingValue:      conflictingValue,
+		Err:                   err,
+	}
+}
+
+func (e ErrRemittanceConflict) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the rule which was broken
+func (e ErrRemittanceConflict) Unwrap() error {
+	return e.Err
+}
+
 // FieldWrongLengthErr is the error given when a Field is the wrong length
 type FieldWrongLengthErr struct {
 	Message     string
@@ -222,3 +319,23 @@ func NewFieldWrongLengthErr(FieldLength, length int) FieldWrongLengthErr {
 func (e FieldWrongLengthErr) Error() string {
 	return e.Message
 }
+
+// FieldTooLongErr is the error given when a value is longer than the Field it is written to
+type FieldTooLongErr struct {
+	Message     string
+	FieldLength int
+	Length      int
+}
+
+// NewFieldTooLongErr creates a new error of the FieldTooLongErr type
+func NewFieldTooLongErr(FieldLength, length int) FieldTooLongErr {
+	return FieldTooLongErr{
+		Message:     fmt.Sprintf("must be at most %d characters and found %d", FieldLength, length),
+		FieldLength: FieldLength,
+		Length:      length,
+	}
+}
+
+func (e FieldTooLongErr) Error() string {
+	return e.Message
+}
@@ -7,13 +7,19 @@ package wire
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
+// ErrFileNoFEDWireMessage is the error given when a file does not contain a FEDWireMessage
+var ErrFileNoFEDWireMessage = errors.New("file does not contain a FEDWireMessage")
+
 // File contains the structures of a parsed WIRE File.
 type File struct {
-	ID             string         `json:"id"`
-	FEDWireMessage FEDWireMessage `json:"fedWireMessage"`
+	ID              string           `json:"id"`
+	FEDWireMessages []FEDWireMessage `json:"fedWireMessages"`
+	// routingDirectory is what Validate and ValidateAll check routing numbers against, when set
+	routingDirectory RoutingDirectory
 }
 
 // NewFile constructs a file template
@@ -23,8 +29,42 @@ func NewFile() *File {
 
 // AddFEDWireMessage appends a FEDWireMessage to the File
 func (f *File) AddFEDWireMessage(fwm FEDWireMessage) FEDWireMessage {
-	f.FEDWireMessage = fwm
-	return f.FEDWireMessage
+	f.FEDWireMessages = append(f.FEDWireMessages, fwm)
+	return fwm
+}
+
+// FEDWireMessage returns the first FEDWireMessage of the File, which is the only FEDWireMessage of a
+// single message File. nil is returned when the File does not contain a FEDWireMessage.
+func (f *File) FEDWireMessage() *FEDWireMessage {
+	if len(f.FEDWireMessages) == 0 {
+		return nil
+	}
+	return &f.FEDWireMessages[0]
+}
+
+// UnmarshalJSON reads a File from JSON, including JSON where a single FEDWireMessage is
+// held in the "fedWireMessage" property.
+func (f *File) UnmarshalJSON(data []byte) error {
+	type Alias File
+	aux := struct {
+		*Alias
+		FEDWireMessage *FEDWireMessage `json:"fedWireMessage"`
+	}{
+		Alias: (*Alias)(f),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.FEDWireMessage != nil {
+		f.FEDWireMessages = append([]FEDWireMessage{*aux.FEDWireMessage}, f.FEDWireMessages...)
+	}
+	return nil
+}
+
+// SetRoutingDirectory sets the RoutingDirectory which Validate and ValidateAll check the routing numbers of the
+// File against, nil removes it and only check digits are validated.
+func (f *File) SetRoutingDirectory(directory RoutingDirectory) {
+	f.routingDirectory = directory
 }
 
 // Create will tabulate and assemble an WIRE file into a valid state.
@@ -37,8 +77,18 @@ func (f *File) Create() error {
 
 // Validate will never modify the file.
 func (f *File) Validate() error {
-	if err := f.FEDWireMessage.verify(); err != nil {
-		return err
+	if len(f.FEDWireMessages) == 0 {
+		return ErrFileNoFEDWireMessage
+	}
+	for i := range f.FEDWireMessages {
+		if err := f.FEDWireMessages[i].verify(); err != nil {
+			return err
+		}
+		if f.routingDirectory != nil {
+			if err := f.FEDWireMessages[i].isParticipant(f.routingDirectory); err != nil {
+				return firstError(err)
+			}
+		}
 	}
 	return nil
 }
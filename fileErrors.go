@@ -51,3 +51,41 @@ func NewErrInvalidTag(tag string) ErrInvalidTag {
 func (e ErrInvalidTag) Error() string {
 	return e.Message
 }
+
+// ErrDuplicateTag is the error given when a tag is repeated within a FEDWireMessage
+type ErrDuplicateTag struct {
+	Message string
+	Type    string
+}
+
+// NewErrDuplicateTag creates a new error of the ErrDuplicateTag type
+func NewErrDuplicateTag(tag string) ErrDuplicateTag {
+	return ErrDuplicateTag{
+		Message: fmt.Sprintf("%s is a duplicate tag", tag),
+		Type:    tag,
+	}
+}
+
+func (e ErrDuplicateTag) Error() string {
+	return e.Message
+}
+
+// ErrTagOutOfOrder is the error given when a tag belongs before the tag preceding it in a FEDWireMessage
+type ErrTagOutOfOrder struct {
+	Message  string
+	Type     string
+	Previous string
+}
+
+// NewErrTagOutOfOrder creates a new error of the ErrTagOutOfOrder type
+func NewErrTagOutOfOrder(tag, previous string) ErrTagOutOfOrder {
+	return ErrTagOutOfOrder{
+		Message:  fmt.Sprintf("%s is out of order, it must precede %s", tag, previous),
+		Type:     tag,
+		Previous: previous,
+	}
+}
+
+func (e ErrTagOutOfOrder) Error() string {
+	return e.Message
+}
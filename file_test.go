@@ -5,8 +5,11 @@
 package wire
 
 import (
+	"bytes"
+	"encoding/json"
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -28,7 +31,101 @@ func TestFile__FileFromJSON(t *testing.T) {
 	if file.ID != "" {
 		t.Error("id isn't set in JSON")
 	}
-	if file.FEDWireMessage.FIAdditionalFIToFI == nil {
+	if file.FEDWireMessage().FIAdditionalFIToFI == nil {
 		t.Error("FIAdditionalFIToFI shouldn't be nil")
 	}
 }
+
+func TestFile__FEDWireMessagesJSON(t *testing.T) {
+	file := NewFile()
+	if file.FEDWireMessage() != nil {
+		t.Error("expected nil FEDWireMessage")
+	}
+	if err := file.Validate(); err != ErrFileNoFEDWireMessage {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	file.AddFEDWireMessage(mockCustomerTransferData())
+	file.AddFEDWireMessage(mockCustomerTransferData())
+
+	bs, err := json.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := FileFromJSON(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(out.FEDWireMessages); n != 2 {
+		t.Errorf("got %d FEDWireMessages", n)
+	}
+	if out.FEDWireMessage().SenderSupplied == nil {
+		t.Error("SenderSupplied shouldn't be nil")
+	}
+}
+
+// TestFile__FileFromJSONWrite writes a File read from JSON as FAIM text which reads back
+func TestFile__FileFromJSONWrite(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := FileFromJSON(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag := file.FEDWireMessage().SenderSupplied.tag; tag != TagSenderSupplied {
+		t.Errorf("SenderSupplied tag=%q", tag)
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).Write(file); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewReader(&buf).Read(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestFile__FileFromJSONTooLong validates a value read from JSON which is longer than its field is rejected rather
+// than cut short
+func TestFile__FileFromJSONTooLong(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		old, new, expected string
+	}{
+		{`"userRequestCorrelation": "User Req"`, `"userRequestCorrelation": "WAYTOOLONGCORRELATION"`,
+			"SenderSupplied.UserRequestCorrelation WAYTOOLONGCORRELATION must be at most 8 characters and found 21"},
+		{`"name": "FI Name"`, `"name": "` + strings.Repeat("N", 36) + `"`,
+			"must be at most 35 characters and found 36"},
+	} {
+		if !bytes.Contains(bs, []byte(tc.old)) {
+			t.Fatalf("%s not found", tc.old)
+		}
+		_, err := FileFromJSON(bytes.Replace(bs, []byte(tc.old), []byte(tc.new), 1))
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+// TestFile__FileFromJSONValues validates the values read from JSON are kept as they are, only their tags are set
+func TestFile__FileFromJSONValues(t *testing.T) {
+	var fwm FEDWireMessage
+	if err := json.Unmarshal([]byte(`{"senderSupplied":{"formatVersion":"30","userRequestCorrelation":" UserRe","testProductionCode":"T","messageDuplicationCode":""}}`), &fwm); err != nil {
+		t.Fatal(err)
+	}
+	if fwm.SenderSupplied.tag != TagSenderSupplied || fwm.SenderSupplied.UserRequestCorrelation != " UserRe" {
+		t.Errorf("unexpected SenderSupplied: %#v", fwm.SenderSupplied)
+	}
+	if err := fwm.SenderSupplied.Validate(); err != nil {
+		t.Error(err)
+	}
+	fwm.SenderSupplied.UserRequestCorrelation = "User\x00Re"
+	if err := fwm.SenderSupplied.Validate(); err == nil {
+		t.Error("expected error")
+	}
+}
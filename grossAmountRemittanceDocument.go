@@ -30,6 +30,11 @@ func NewGrossAmountRemittanceDocument() *GrossAmountRemittanceDocument {
 	return gard
 }
 
+// setTag sets the tag of GrossAmountRemittanceDocument, which JSON does not hold
+func (gard *GrossAmountRemittanceDocument) setTag() {
+	gard.tag = TagGrossAmountRemittanceDocument
+}
+
 // Parse takes the input string and parses the GrossAmountRemittanceDocument values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -0,0 +1,122 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/moov-io/wire/internal/atomicfile"
+)
+
+// maxInputSequenceNumber is the largest six digit InputSequenceNumber
+const maxInputSequenceNumber = 999999
+
+// IMADAllocator allocates the InputMessageAccountabilityData (IMAD) of FEDWireMessages. The InputSequenceNumbers
+// of an input source increase from 000001 on each cycle date and are never allocated twice. Once allocated the
+// InputSequenceNumber is saved to the state file, when there is one, so it is not allocated again after a
+// restart.
+type IMADAllocator struct {
+	mu    sync.Mutex
+	path  string
+	state imadAllocatorState
+}
+
+// imadAllocatorState is the state of an IMADAllocator, which is saved as JSON
+type imadAllocatorState struct {
+	// Sources is the latest IMAD allocated for each input source
+	Sources map[string]imadAllocation `json:"sources"`
+}
+
+// imadAllocation is the latest IMAD allocated for an input source
+type imadAllocation struct {
+	CycleDate string `json:"cycleDate"`
+	Sequence  int    `json:"sequence"`
+}
+
+// NewIMADAllocator returns an IMADAllocator which saves its state to the file at path, reading the state saved
+// by a previous IMADAllocator when the file exists. The IMADAllocator only holds its state in memory when path
+// is empty.
+func NewIMADAllocator(path string) (*IMADAllocator, error) {
+	a := &IMADAllocator{
+		path:  path,
+		state: imadAllocatorState{Sources: make(map[string]imadAllocation)},
+	}
+	if path == "" {
+		return a, nil
+	}
+	bs, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return a, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bs, &a.state); err != nil {
+		return nil, fmt.Errorf("problem reading IMAD allocator state %s: %v", path, err)
+	}
+	if a.state.Sources == nil {
+		a.state.Sources = make(map[string]imadAllocation)
+	}
+	return a, nil
+}
+
+// Allocate returns the next InputMessageAccountabilityData of source on cycleDate (CCYYMMDD). A cycle date after
+// the latest cycle date of source starts again from InputSequenceNumber 000001, an earlier cycle date is an
+// error.
+func (a *IMADAllocator) Allocate(source, cycleDate string) (*InputMessageAccountabilityData, error) {
+	imad := NewInputMessageAccountabilityData()
+	imad.InputSource = source
+	imad.InputCycleDate = cycleDate
+	imad.InputSequenceNumber = "000001"
+	if err := imad.Validate(); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	latest, ok := a.state.Sources[source]
+	next := imadAllocation{CycleDate: cycleDate, Sequence: 1}
+	if ok {
+		switch {
+		case cycleDate < latest.CycleDate:
+			return nil, fieldError("InputCycleDate", ErrPriorCycleDate, cycleDate)
+		case cycleDate == latest.CycleDate:
+			if latest.Sequence >= maxInputSequenceNumber {
+				return nil, ErrInputSequenceNumberExhausted
+			}
+			next.Sequence = latest.Sequence + 1
+		}
+	}
+
+	a.state.Sources[source] = next
+	if err := a.save(); err != nil {
+		// the InputSequenceNumber is not allocated when it could not be saved
+		if ok {
+			a.state.Sources[source] = latest
+		} else {
+			delete(a.state.Sources, source)
+		}
+		return nil, err
+	}
+	imad.InputSequenceNumber = fmt.Sprintf("%06d", next.Sequence)
+	return imad, nil
+}
+
+// save writes the state to the file at path, replacing the previous state only once the state is written
+func (a *IMADAllocator) save() error {
+	if a.path == "" {
+		return nil
+	}
+	bs, err := json.Marshal(a.state)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(a.path, bs)
+}
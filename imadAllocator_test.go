@@ -0,0 +1,92 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/base"
+)
+
+// TestIMADAllocator validates InputSequenceNumbers increase per input source and roll over on a new cycle date
+func TestIMADAllocator(t *testing.T) {
+	a, err := NewIMADAllocator("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []struct {
+		source, cycleDate, sequence string
+	}{
+		{"Source08", "20190410", "000001"},
+		{"Source08", "20190410", "000002"},
+		{"Source09", "20190410", "000001"},
+		{"Source08", "20190411", "000001"},
+	} {
+		imad, err := a.Allocate(want.source, want.cycleDate)
+		if err != nil {
+			t.Fatalf("%T: %s", err, err)
+		}
+		if imad.InputSource != want.source || imad.InputCycleDate != want.cycleDate || imad.InputSequenceNumber != want.sequence {
+			t.Errorf("got %s, want %v", imad, want)
+		}
+		if err := imad.Validate(); err != nil {
+			t.Errorf("%T: %s", err, err)
+		}
+	}
+
+	if _, err := a.Allocate("Source08", "20190410"); !base.Match(err, ErrPriorCycleDate) {
+		t.Errorf("%T: %s", err, err)
+	}
+	if _, err := a.Allocate("Source08", "2019041Z"); err == nil {
+		t.Error("expected error")
+	}
+
+	a.state.Sources["Source08"] = imadAllocation{CycleDate: "20190411", Sequence: maxInputSequenceNumber}
+	if _, err := a.Allocate("Source08", "20190411"); err != ErrInputSequenceNumberExhausted {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestIMADAllocatorState validates InputSequenceNumbers are not allocated again after a restart
+func TestIMADAllocatorState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "imad")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "imad.json")
+
+	a, err := NewIMADAllocator(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := a.Allocate("Source08", "20190410"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	a, err = NewIMADAllocator(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imad, err := a.Allocate("Source08", "20190410")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imad.InputSequenceNumber != "000004" {
+		t.Errorf("InputSequenceNumber %s", imad.InputSequenceNumber)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewIMADAllocator(path); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -34,6 +34,11 @@ func NewInputMessageAccountabilityData() *InputMessageAccountabilityData {
 	return imad
 }
 
+// setTag sets the tag of InputMessageAccountabilityData, which JSON does not hold
+func (imad *InputMessageAccountabilityData) setTag() {
+	imad.tag = TagInputMessageAccountabilityData
+}
+
 // Parse takes the input string and parses the InputMessageAccountabilityData values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -30,6 +30,11 @@ func NewInstitutionAccount() *InstitutionAccount {
 	return iAccount
 }
 
+// setTag sets the tag of InstitutionAccount, which JSON does not hold
+func (iAccount *InstitutionAccount) setTag() {
+	iAccount.tag = TagInstitutionAccount
+}
+
 // Parse takes the input string and parses the InstitutionAccount values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
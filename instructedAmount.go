@@ -33,6 +33,11 @@ func NewInstructedAmount() *InstructedAmount {
 	return ia
 }
 
+// setTag sets the tag of InstructedAmount, which JSON does not hold
+func (ia *InstructedAmount) setTag() {
+	ia.tag = TagInstructedAmount
+}
+
 // Parse takes the input string and parses the InstructedAmount values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
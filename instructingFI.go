@@ -30,6 +30,11 @@ func NewInstructingFI() *InstructingFI {
 	return ifi
 }
 
+// setTag sets the tag of InstructingFI, which JSON does not hold
+func (ifi *InstructingFI) setTag() {
+	ifi.tag = TagInstructingFI
+}
+
 // Parse takes the input string and parses the InstructingFI values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -84,6 +89,9 @@ func (ifi *InstructingFI) Validate() error {
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Identifier); err != nil {
 		return fieldError("Identifier", err, ifi.FinancialInstitution.Identifier)
 	}
+	if err := ifi.isIdentifier(ifi.FinancialInstitution.IdentificationCode, ifi.FinancialInstitution.Identifier); err != nil {
+		return fieldError("Identifier", err, ifi.FinancialInstitution.Identifier)
+	}
 	if err := ifi.isAlphanumeric(ifi.FinancialInstitution.Name); err != nil {
 		return fieldError("Name", err, ifi.FinancialInstitution.Name)
 	}
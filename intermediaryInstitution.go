@@ -30,6 +30,11 @@ func NewIntermediaryInstitution() *IntermediaryInstitution {
 	return ii
 }
 
+// setTag sets the tag of IntermediaryInstitution, which JSON does not hold
+func (ii *IntermediaryInstitution) setTag() {
+	ii.tag = TagIntermediaryInstitution
+}
+
 // Parse takes the input string and parses the IntermediaryInstitution values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
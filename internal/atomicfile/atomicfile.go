@@ -0,0 +1,61 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package atomicfile writes files so readers see either their previous contents or the new contents, never a
+// partial write.
+package atomicfile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// WriteFile writes data to a temporary file in the directory of path and renames it to path once it is synced,
+// so path holds either its previous contents or data. The directory is synced after the rename so path survives
+// a crash. The temporary file is hidden, starting with a dot, and does not end with the extension of path.
+func WriteFile(path string, data []byte) error {
+	fd, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmp := fd.Name()
+	if _, err := fd.Write(data); err != nil {
+		fd.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := fd.Sync(); err != nil {
+		fd.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return syncDir(filepath.Dir(path))
+}
+
+// syncDir syncs the directory dir, so the entries renamed into it are durable. Windows can not sync a
+// directory, so there it does nothing.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	fd, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err := fd.Sync(); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
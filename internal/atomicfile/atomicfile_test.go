@@ -0,0 +1,55 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package atomicfile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestWriteFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "atomicfile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "file.json")
+	for _, data := range []string{"first", "second"} {
+		if err := WriteFile(path, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+		if bs, err := ioutil.ReadFile(path); err != nil || string(bs) != data {
+			t.Errorf("got %q error=%v", bs, err)
+		}
+	}
+
+	// no temporary file is left behind
+	if infos, err := ioutil.ReadDir(dir); err != nil || len(infos) != 1 {
+		t.Errorf("files=%v error=%v", infos, err)
+	}
+
+	if err := WriteFile(filepath.Join(dir, "missing", "file.json"), nil); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestSyncDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "atomicfile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := syncDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := syncDir(filepath.Join(dir, "missing")); err == nil && runtime.GOOS != "windows" {
+		t.Error("expected error")
+	}
+}
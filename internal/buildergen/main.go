@@ -0,0 +1,246 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// buildergen writes the setters every business function code builder shares, such as CustomerTransferBuilder,
+// along with a setter for each tag the builder may set. The setters return the builder so they can be chained,
+// which is why each builder needs its own copy rather than one promoted from messageBuilder.
+//
+// Run it with go generate from the root of the repository.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+	"text/template"
+)
+
+// tags are the tag numbers of the tags a builder may set beyond the mandatory tags
+var tags = map[string]string{
+	"SenderReference":               "3320",
+	"PreviousMessageIdentifier":     "3500",
+	"LocalInstrument":               "3610",
+	"PaymentNotification":           "3620",
+	"Charges":                       "3700",
+	"InstructedAmount":              "3710",
+	"ExchangeRate":                  "3720",
+	"BeneficiaryIntermediaryFI":     "4000",
+	"BeneficiaryFI":                 "4100",
+	"Beneficiary":                   "4200",
+	"BeneficiaryReference":          "4320",
+	"AccountDebitedDrawdown":        "4400",
+	"Originator":                    "5000",
+	"OriginatorOptionF":             "5010",
+	"OriginatorFI":                  "5100",
+	"InstructingFI":                 "5200",
+	"AccountCreditedDrawdown":       "5400",
+	"OriginatorToBeneficiary":       "6000",
+	"FIReceiverFI":                  "6100",
+	"FIDrawdownDebitAccountAdvice":  "6110",
+	"FIIntermediaryFI":              "6200",
+	"FIIntermediaryFIAdvice":        "6210",
+	"FIBeneficiaryFI":               "6300",
+	"FIBeneficiaryFIAdvice":         "6310",
+	"FIBeneficiary":                 "6400",
+	"FIBeneficiaryAdvice":           "6410",
+	"FIPaymentMethodToBeneficiary":  "6420",
+	"FIAdditionalFIToFI":            "6500",
+	"CurrencyInstructedAmount":      "7033",
+	"OrderingCustomer":              "7050",
+	"OrderingInstitution":           "7052",
+	"IntermediaryInstitution":       "7056",
+	"InstitutionAccount":            "7057",
+	"BeneficiaryCustomer":           "7059",
+	"Remittance":                    "7070",
+	"SenderToReceiver":              "7072",
+	"UnstructuredAddenda":           "8200",
+	"RelatedRemittance":             "8250",
+	"RemittanceOriginator":          "8300",
+	"RemittanceBeneficiary":         "8350",
+	"PrimaryRemittanceDocument":     "8400",
+	"ActualAmountPaid":              "8450",
+	"GrossAmountRemittanceDocument": "8500",
+	"AmountNegotiatedDiscount":      "8550",
+	"Adjustment":                    "8600",
+	"DateRemittanceDocument":        "8650",
+	"SecondaryRemittanceDocument":   "8700",
+	"RemittanceFreeText":            "8750",
+	"ServiceMessage":                "9000",
+}
+
+// fiToFI are the financial institution to financial institution tags {6100} to {6500} most builders may set
+var fiToFI = []string{
+	"FIReceiverFI", "FIIntermediaryFI", "FIIntermediaryFIAdvice", "FIBeneficiaryFI", "FIBeneficiaryFIAdvice",
+	"FIBeneficiary", "FIBeneficiaryAdvice", "FIPaymentMethodToBeneficiary", "FIAdditionalFIToFI",
+}
+
+type builder struct {
+	Name string
+	Tags []string
+}
+
+var builders = []builder{
+	{
+		Name: "BankTransferBuilder",
+		Tags: join([]string{
+			"SenderReference", "PreviousMessageIdentifier", "BeneficiaryIntermediaryFI", "BeneficiaryFI",
+			"Beneficiary", "BeneficiaryReference", "Originator", "OriginatorFI", "InstructingFI",
+			"OriginatorToBeneficiary",
+		}, fiToFI),
+	},
+	{
+		Name: "CustomerTransferBuilder",
+		Tags: join([]string{
+			"SenderReference", "PreviousMessageIdentifier", "Charges", "InstructedAmount", "ExchangeRate",
+			"BeneficiaryIntermediaryFI", "BeneficiaryFI", "Beneficiary", "BeneficiaryReference", "Originator",
+			"OriginatorFI", "InstructingFI", "OriginatorToBeneficiary",
+		}, fiToFI),
+	},
+	{
+		Name: "CustomerTransferPlusBuilder",
+		Tags: join([]string{
+			"SenderReference", "PreviousMessageIdentifier", "LocalInstrument", "PaymentNotification", "Charges",
+			"InstructedAmount", "ExchangeRate", "BeneficiaryIntermediaryFI", "BeneficiaryFI", "Beneficiary",
+			"BeneficiaryReference", "Originator", "OriginatorOptionF", "OriginatorFI", "InstructingFI",
+			"OriginatorToBeneficiary",
+		}, fiToFI[1:], []string{
+			"CurrencyInstructedAmount", "OrderingCustomer", "OrderingInstitution", "IntermediaryInstitution",
+			"InstitutionAccount", "BeneficiaryCustomer", "Remittance", "SenderToReceiver", "UnstructuredAddenda",
+			"RelatedRemittance", "RemittanceOriginator", "RemittanceBeneficiary", "PrimaryRemittanceDocument",
+			"ActualAmountPaid", "GrossAmountRemittanceDocument", "AmountNegotiatedDiscount", "Adjustment",
+			"DateRemittanceDocument", "SecondaryRemittanceDocument", "RemittanceFreeText",
+		}),
+	},
+	{
+		Name: "DrawdownRequestBuilder",
+		Tags: join([]string{
+			"SenderReference", "PreviousMessageIdentifier", "BeneficiaryIntermediaryFI", "BeneficiaryFI",
+			"Beneficiary", "BeneficiaryReference", "AccountDebitedDrawdown", "Originator", "OriginatorFI",
+			"InstructingFI", "AccountCreditedDrawdown", "OriginatorToBeneficiary",
+		}, fiToFI[:1], []string{"FIDrawdownDebitAccountAdvice"}, fiToFI[1:]),
+	},
+	{
+		Name: "FEDFundsSoldBuilder",
+		Tags: join([]string{
+			"SenderReference", "PreviousMessageIdentifier", "BeneficiaryIntermediaryFI", "BeneficiaryFI",
+			"Beneficiary", "BeneficiaryReference", "Originator", "OriginatorFI", "InstructingFI",
+			"OriginatorToBeneficiary",
+		}, fiToFI),
+	},
+	{
+		Name: "ServiceMessageBuilder",
+		Tags: join([]string{
+			"SenderReference", "PreviousMessageIdentifier", "BeneficiaryIntermediaryFI", "BeneficiaryFI",
+			"Beneficiary", "BeneficiaryReference", "AccountDebitedDrawdown", "Originator", "OriginatorFI",
+			"InstructingFI", "AccountCreditedDrawdown", "OriginatorToBeneficiary",
+		}, fiToFI[:1], []string{"FIDrawdownDebitAccountAdvice"}, fiToFI[1:], []string{"ServiceMessage"}),
+	},
+}
+
+func join(tags ...[]string) []string {
+	var out []string
+	for i := range tags {
+		out = append(out, tags[i]...)
+	}
+	return out
+}
+
+var funcs = template.FuncMap{
+	"tag": func(name string) string {
+		tag, ok := tags[name]
+		if !ok {
+			log.Fatalf("no tag for %s", name)
+		}
+		return tag
+	},
+	// param returns the parameter name of a setter, such as fiReceiverFI for FIReceiverFI
+	"param": func(name string) string {
+		if strings.HasPrefix(name, "FI") {
+			return "fi" + name[2:]
+		}
+		return strings.ToLower(name[:1]) + name[1:]
+	},
+	"typeSubType": func(name string) string {
+		return strings.TrimSuffix(name, "Builder") + "TypeSubType"
+	},
+}
+
+var setters = template.Must(template.New("setters").Funcs(funcs).Parse(`// Code generated by internal/buildergen; DO NOT EDIT.
+
+package wire
+{{range .}}{{$b := .Name}}
+// UserRequestCorrelation sets the UserRequestCorrelation of SenderSupplied {1500}
+func (b *{{$b}}) UserRequestCorrelation(userRequestCorrelation string) *{{$b}} {
+	b.userRequestCorrelation(userRequestCorrelation)
+	return b
+}
+
+// TestProductionCode sets the TestProductionCode of SenderSupplied {1500}, which defaults to production
+func (b *{{$b}}) TestProductionCode(testProductionCode string) *{{$b}} {
+	b.testProductionCode(testProductionCode)
+	return b
+}
+
+// TypeSubType sets TypeSubType {1510}
+func (b *{{$b}}) TypeSubType(typeSubType {{typeSubType $b}}) *{{$b}} {
+	b.typeSubType(builderTypeSubType(typeSubType))
+	return b
+}
+
+// InputMessageAccountabilityData sets InputMessageAccountabilityData {1520}
+func (b *{{$b}}) InputMessageAccountabilityData(cycleDate, source, sequenceNumber string) *{{$b}} {
+	b.imad(cycleDate, source, sequenceNumber)
+	return b
+}
+
+// Amount sets Amount {2000}
+func (b *{{$b}}) Amount(amount string) *{{$b}} {
+	b.amount(amount)
+	return b
+}
+
+// SenderDepositoryInstitution sets SenderDepositoryInstitution {3100}
+func (b *{{$b}}) SenderDepositoryInstitution(abaNumber, shortName string) *{{$b}} {
+	b.senderDepositoryInstitution(abaNumber, shortName)
+	return b
+}
+
+// ReceiverDepositoryInstitution sets ReceiverDepositoryInstitution {3400}
+func (b *{{$b}}) ReceiverDepositoryInstitution(abaNumber, shortName string) *{{$b}} {
+	b.receiverDepositoryInstitution(abaNumber, shortName)
+	return b
+}
+{{range .Tags}}
+// {{.}} sets {{.}} { {{- tag .}}}
+func (b *{{$b}}) {{.}}({{param .}} *{{.}}) *{{$b}} {
+	b.fwm.Set{{.}}({{param .}})
+	return b
+}
+{{end}}
+// Build returns the FEDWireMessage, along with ValidationErrors of every problem found with it when it is not
+// valid
+func (b *{{$b}}) Build() (FEDWireMessage, error) {
+	return b.build()
+}
+{{end}}`))
+
+func main() {
+	output := flag.String("output", "builderSetters.go", "file to write the builder setters to")
+	flag.Parse()
+
+	var buf bytes.Buffer
+	if err := setters.Execute(&buf, builders); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,456 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/moov-io/wire"
+)
+
+const (
+	// clearingSystemFedwire identifies the Fedwire Funds Service in SttlmInf/ClrSys
+	clearingSystemFedwire = "FDW"
+	// clearingSystemABA identifies an ABA routing number in ClrSysMmbId/ClrSysId
+	clearingSystemABA = "USABA"
+	// clearingSystemCHIPS identifies a CHIPS participant in ClrSysMmbId/ClrSysId
+	clearingSystemCHIPS = "USPID"
+	// settlementMethodClearing designates settlement through a clearing system
+	settlementMethodClearing = "CLRG"
+	// notProvided is used for a mandatory reference which is not known
+	notProvided = "NOTPROVIDED"
+)
+
+// now returns the creation time of exported documents
+var now = time.Now
+
+// creationDateTime returns the ISO 20022 creation date time of an exported document
+func creationDateTime() string {
+	return now().UTC().Format("2006-01-02T15:04:05Z")
+}
+
+// decimalAmount converts an amount in cents, e.g. 000001234567, to a decimal amount, e.g. 12345.67
+func decimalAmount(cents string) string {
+	cents = strings.TrimLeft(strings.TrimSpace(cents), "0")
+	for len(cents) < 3 {
+		cents = "0" + cents
+	}
+	return cents[:len(cents)-2] + "." + cents[len(cents)-2:]
+}
+
+// centsAmount converts a decimal amount, e.g. 12345.67, to an amount in cents, e.g. 1234567
+func centsAmount(amount string) string {
+	amount = strings.TrimSpace(amount)
+	units, cents := amount, ""
+	if i := strings.Index(amount, "."); i >= 0 {
+		units, cents = amount[:i], amount[i+1:]
+	}
+	cents = (cents + "00")[:2]
+	return strings.TrimLeft(units+cents, "0")
+}
+
+// periodDecimal converts a FEDWireMessage decimal comma, e.g. 1234,56, to a decimal period, e.g. 1234.56
+func periodDecimal(s string) string {
+	return strings.Replace(strings.TrimSpace(s), ",", ".", 1)
+}
+
+// commaDecimal converts a decimal period, e.g. 1234.56, to a FEDWireMessage decimal comma, e.g. 1234,56
+func commaDecimal(s string) string {
+	return strings.Replace(strings.TrimSpace(s), ".", ",", 1)
+}
+
+// isoDate converts a CCYYMMDD date to an ISO 20022 date, CCYY-MM-DD
+func isoDate(date string) string {
+	if t, err := time.Parse("20060102", strings.TrimSpace(date)); err == nil {
+		return t.Format("2006-01-02")
+	}
+	return date
+}
+
+// wireDate converts an ISO 20022 date, CCYY-MM-DD, to a CCYYMMDD date
+func wireDate(date string) string {
+	if t, err := time.Parse("2006-01-02", strings.TrimSpace(date)); err == nil {
+		return t.Format("20060102")
+	}
+	return date
+}
+
+// proprietary returns a CodeOrProprietary holding a proprietary value, or nil when value is empty
+func proprietary(value string) *CodeOrProprietary {
+	if value = strings.TrimSpace(value); value == "" {
+		return nil
+	}
+	return &CodeOrProprietary{Prtry: value}
+}
+
+// proprietaryValue returns the proprietary value of c, or the code when there is no proprietary value
+func proprietaryValue(c *CodeOrProprietary) string {
+	if c == nil {
+		return ""
+	}
+	if c.Prtry != "" {
+		return c.Prtry
+	}
+	return c.Cd
+}
+
+// postalAddress returns the unstructured postal address of lines, or nil when every line is empty
+func postalAddress(lines ...string) *PostalAddress {
+	n := 0
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+		if lines[i] != "" {
+			n = i + 1
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	return &PostalAddress{AdrLine: lines[:n]}
+}
+
+// addressLine returns the i'th address line of adr
+func addressLine(adr *PostalAddress, i int) string {
+	if adr == nil || i >= len(adr.AdrLine) {
+		return ""
+	}
+	return adr.AdrLine[i]
+}
+
+// wireAddress returns the FEDWireMessage Address of adr
+func wireAddress(adr *PostalAddress) wire.Address {
+	return wire.Address{
+		AddressLineOne:   addressLine(adr, 0),
+		AddressLineTwo:   addressLine(adr, 1),
+		AddressLineThree: addressLine(adr, 2),
+	}
+}
+
+// abaAgent returns the agent identified by an ABA routing number
+func abaAgent(aba, name string) BranchAndFinancialInstitutionIdentification {
+	return BranchAndFinancialInstitutionIdentification{
+		FinInstnId: FinancialInstitutionIdentification{
+			ClrSysMmbId: &ClearingSystemMemberIdentification{
+				ClrSysId: &CodeOrProprietary{Cd: clearingSystemABA},
+				MmbId:    strings.TrimSpace(aba),
+			},
+			Nm: strings.TrimSpace(name),
+		},
+	}
+}
+
+// abaNumber returns the ABA routing number and name of agt
+func abaNumber(agt BranchAndFinancialInstitutionIdentification) (string, string) {
+	id := agt.FinInstnId
+	if id.ClrSysMmbId == nil {
+		return "", id.Nm
+	}
+	return id.ClrSysMmbId.MmbId, id.Nm
+}
+
+// agent returns the agent of fi, or nil when fi is empty. Identification codes without an ISO 20022 element
+// are carried as the proprietary scheme name of FinInstnId/Othr.
+func agent(fi wire.FinancialInstitution) *BranchAndFinancialInstitutionIdentification {
+	if fi == (wire.FinancialInstitution{}) {
+		return nil
+	}
+	id := FinancialInstitutionIdentification{
+		Nm:      strings.TrimSpace(fi.Name),
+		PstlAdr: postalAddress(fi.Address.AddressLineOne, fi.Address.AddressLineTwo, fi.Address.AddressLineThree),
+	}
+	identifier := strings.TrimSpace(fi.Identifier)
+	switch fi.IdentificationCode {
+	case wire.SWIFTBankIdentifierCode:
+		id.BICFI = identifier
+	case wire.FEDRoutingNumber:
+		id.ClrSysMmbId = &ClearingSystemMemberIdentification{
+			ClrSysId: &CodeOrProprietary{Cd: clearingSystemABA},
+			MmbId:    identifier,
+		}
+	case wire.CHIPSParticipant:
+		id.ClrSysMmbId = &ClearingSystemMemberIdentification{
+			ClrSysId: &CodeOrProprietary{Cd: clearingSystemCHIPS},
+			MmbId:    identifier,
+		}
+	default:
+		if fi.IdentificationCode != "" || identifier != "" {
+			id.Othr = &GenericIdentification{
+				Id:      identifier,
+				SchmeNm: proprietary(fi.IdentificationCode),
+			}
+		}
+	}
+	return &BranchAndFinancialInstitutionIdentification{FinInstnId: id}
+}
+
+// financialInstitution returns the FEDWireMessage FinancialInstitution of agt
+func financialInstitution(agt *BranchAndFinancialInstitutionIdentification) wire.FinancialInstitution {
+	if agt == nil {
+		return wire.FinancialInstitution{}
+	}
+	id := agt.FinInstnId
+	fi := wire.FinancialInstitution{
+		Name:    id.Nm,
+		Address: wireAddress(id.PstlAdr),
+	}
+	switch {
+	case id.BICFI != "":
+		fi.IdentificationCode, fi.Identifier = wire.SWIFTBankIdentifierCode, id.BICFI
+	case id.ClrSysMmbId != nil && proprietaryValue(id.ClrSysMmbId.ClrSysId) == clearingSystemCHIPS:
+		fi.IdentificationCode, fi.Identifier = wire.CHIPSParticipant, id.ClrSysMmbId.MmbId
+	case id.ClrSysMmbId != nil:
+		fi.IdentificationCode, fi.Identifier = wire.FEDRoutingNumber, id.ClrSysMmbId.MmbId
+	case id.Othr != nil:
+		fi.IdentificationCode, fi.Identifier = proprietaryValue(id.Othr.SchmeNm), id.Othr.Id
+	}
+	return fi
+}
+
+// partyScheme is the ISO 20022 identification scheme of a FEDWireMessage party identification code
+type partyScheme struct {
+	code         string
+	organisation bool
+	scheme       CodeOrProprietary
+}
+
+// partySchemes are the identification schemes of the FEDWireMessage party identification codes, other than
+// DemandDepositAccountNumber which identifies an account and SWIFTBankIdentifierCode which is AnyBIC.
+// Identification codes without an ISO 20022 scheme are carried as a proprietary scheme name.
+var partySchemes = []partyScheme{
+	{wire.PassportNumber, false, CodeOrProprietary{Cd: "CCPT"}},
+	{wire.TaxIdentificationNumber, false, CodeOrProprietary{Cd: "TXID"}},
+	{wire.DriversLicenseNumber, false, CodeOrProprietary{Cd: "DRLC"}},
+	{wire.AlienRegistrationNumber, false, CodeOrProprietary{Cd: "ARNU"}},
+	{wire.CorporateIdentification, true, CodeOrProprietary{Cd: "CINC"}},
+	{wire.OtherIdentification, false, CodeOrProprietary{Prtry: wire.OtherIdentification}},
+	{wire.CHIPSParticipant, true, CodeOrProprietary{Prtry: wire.CHIPSParticipant}},
+	{wire.FEDRoutingNumber, true, CodeOrProprietary{Prtry: wire.FEDRoutingNumber}},
+	{wire.SWIFTBICORBEIANDAccountNumber, true, CodeOrProprietary{Prtry: wire.SWIFTBICORBEIANDAccountNumber}},
+	{wire.CHIPSIdentifier, true, CodeOrProprietary{Prtry: wire.CHIPSIdentifier}},
+}
+
+// party returns the party, and account, of p
+func party(p wire.Personal) (*PartyIdentification, *CashAccount) {
+	pty := &PartyIdentification{
+		Nm:      strings.TrimSpace(p.Name),
+		PstlAdr: postalAddress(p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree),
+	}
+	identifier := strings.TrimSpace(p.Identifier)
+	switch p.IdentificationCode {
+	case "":
+	case wire.DemandDepositAccountNumber:
+		return pty, &CashAccount{Id: AccountIdentification{Othr: &GenericIdentification{Id: identifier}}}
+	case wire.SWIFTBankIdentifierCode:
+		pty.Id = &Party{OrgId: &OrganisationIdentification{AnyBIC: identifier}}
+	default:
+		scheme := partyScheme{code: p.IdentificationCode, organisation: true, scheme: CodeOrProprietary{Prtry: p.IdentificationCode}}
+		for i := range partySchemes {
+			if partySchemes[i].code == p.IdentificationCode {
+				scheme = partySchemes[i]
+			}
+		}
+		id := []GenericIdentification{{Id: identifier, SchmeNm: &scheme.scheme}}
+		if scheme.organisation {
+			pty.Id = &Party{OrgId: &OrganisationIdentification{Othr: id}}
+		} else {
+			pty.Id = &Party{PrvtId: &PersonIdentification{Othr: id}}
+		}
+	}
+	return pty, nil
+}
+
+// personal returns the FEDWireMessage Personal of pty and acct
+func personal(pty *PartyIdentification, acct *CashAccount) wire.Personal {
+	var p wire.Personal
+	if pty != nil {
+		p.Name = pty.Nm
+		p.Address = wireAddress(pty.PstlAdr)
+	}
+	switch {
+	case acct != nil && acct.Id.IBAN != "":
+		p.IdentificationCode, p.Identifier = wire.DemandDepositAccountNumber, acct.Id.IBAN
+	case acct != nil && acct.Id.Othr != nil:
+		p.IdentificationCode, p.Identifier = wire.DemandDepositAccountNumber, acct.Id.Othr.Id
+	case pty == nil || pty.Id == nil:
+	case pty.Id.OrgId != nil && pty.Id.OrgId.AnyBIC != "":
+		p.IdentificationCode, p.Identifier = wire.SWIFTBankIdentifierCode, pty.Id.OrgId.AnyBIC
+	case pty.Id.OrgId != nil && len(pty.Id.OrgId.Othr) > 0:
+		p.IdentificationCode, p.Identifier = partyCode(true, pty.Id.OrgId.Othr[0])
+	case pty.Id.PrvtId != nil && len(pty.Id.PrvtId.Othr) > 0:
+		p.IdentificationCode, p.Identifier = partyCode(false, pty.Id.PrvtId.Othr[0])
+	}
+	return p
+}
+
+// partyCode returns the FEDWireMessage identification code and identifier of id
+func partyCode(organisation bool, id GenericIdentification) (string, string) {
+	if id.SchmeNm != nil {
+		for i := range partySchemes {
+			if partySchemes[i].organisation == organisation && partySchemes[i].scheme == *id.SchmeNm {
+				return partySchemes[i].code, id.Id
+			}
+		}
+		for i := range partySchemes {
+			if partySchemes[i].scheme == *id.SchmeNm {
+				return partySchemes[i].code, id.Id
+			}
+		}
+	}
+	if organisation {
+		return wire.CorporateIdentification, id.Id
+	}
+	return wire.OtherIdentification, id.Id
+}
+
+// optionFParty returns the party, and account, of OriginatorOptionF {5010}
+func optionFParty(oof *wire.OriginatorOptionF) (*PartyIdentification, *CashAccount) {
+	pty := &PartyIdentification{Nm: strings.TrimPrefix(strings.TrimSpace(oof.Name), "1/")}
+	var lines []string
+	for _, line := range []string{oof.LineOne, oof.LineTwo, oof.LineThree} {
+		lines = append(lines, strings.TrimPrefix(strings.TrimSpace(line), "2/"))
+	}
+	pty.PstlAdr = postalAddress(lines...)
+	identifier := strings.TrimSpace(oof.PartyIdentifier)
+	if strings.HasPrefix(identifier, "/") {
+		return pty, &CashAccount{Id: AccountIdentification{Othr: &GenericIdentification{Id: identifier[1:]}}}
+	}
+	if i := strings.Index(identifier, "/"); i > 0 {
+		pty.Id = &Party{PrvtId: &PersonIdentification{Othr: []GenericIdentification{{
+			Id:      identifier[i+1:],
+			SchmeNm: &CodeOrProprietary{Cd: identifier[:i]},
+		}}}}
+	}
+	return pty, nil
+}
+
+// normalized returns a copy of fwm with every tag formatted and parsed as the Writer and Reader do, so values
+// which do not fit a tag are truncated.
+func normalized(fwm *wire.FEDWireMessage) *wire.FEDWireMessage {
+	out := *fwm
+	v := reflect.ValueOf(&out).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() != reflect.Ptr || f.IsNil() {
+			continue
+		}
+		s, ok := f.Interface().(fmt.Stringer)
+		if !ok {
+			continue
+		}
+		tag := reflect.New(f.Type().Elem())
+		parse := tag.MethodByName("Parse")
+		if !parse.IsValid() {
+			continue
+		}
+		result := parse.Call([]reflect.Value{reflect.ValueOf(s.String())})
+		if len(result) == 1 && !result[0].IsNil() {
+			continue
+		}
+		f.Set(tag)
+	}
+	return &out
+}
+
+// messageLosses returns the values of original which are not the same in converted
+func messageLosses(original, converted *wire.FEDWireMessage) Losses {
+	var losses Losses
+	o, c := reflect.ValueOf(original).Elem(), reflect.ValueOf(converted).Elem()
+	for i := 0; i < o.NumField(); i++ {
+		of, cf := o.Field(i), c.Field(i)
+		if of.Kind() != reflect.Ptr || of.IsNil() {
+			continue
+		}
+		tag := of.Elem().FieldByName("tag").String()
+		if cf.IsNil() {
+			cf = reflect.New(cf.Type().Elem())
+		}
+		compareValues(of.Elem(), cf.Elem(), "", ".", func(field, value, conv string) {
+			losses = append(losses, Loss{Tag: tag, Field: field, Value: value, Converted: conv})
+		})
+	}
+	return losses
+}
+
+// documentLosses returns the elements of original which are not the same in converted
+func documentLosses(original, converted interface{}) Losses {
+	var losses Losses
+	compareValues(reflect.ValueOf(original).Elem(), reflect.ValueOf(converted).Elem(), "", "/", func(element, value, conv string) {
+		losses = append(losses, Loss{Element: element, Value: value, Converted: conv})
+	})
+	return losses
+}
+
+var elementType = reflect.TypeOf(Element{})
+
+// compareValues calls lost for each non-empty string of o which is not the same in c, ignoring padding. Struct
+// fields are named by their XML element name when they have one and joined to path with sep.
+func compareValues(o, c reflect.Value, path, sep string, lost func(path, value, converted string)) {
+	switch o.Kind() {
+	case reflect.String:
+		value, conv := strings.TrimSpace(o.String()), strings.TrimSpace(c.String())
+		if value != "" && value != conv {
+			lost(path, value, conv)
+		}
+	case reflect.Ptr:
+		if o.IsNil() {
+			return
+		}
+		if c.IsNil() {
+			c = reflect.New(c.Type().Elem())
+		}
+		compareValues(o.Elem(), c.Elem(), path, sep, lost)
+	case reflect.Slice:
+		for i := 0; i < o.Len(); i++ {
+			var cv reflect.Value
+			if i < c.Len() {
+				cv = c.Index(i)
+			} else {
+				cv = reflect.New(o.Type().Elem()).Elem()
+			}
+			p := path
+			if o.Len() > 1 && o.Type().Elem() != elementType {
+				p = fmt.Sprintf("%s[%d]", path, i)
+			}
+			compareValues(o.Index(i), cv, p, sep, lost)
+		}
+	case reflect.Struct:
+		if o.Type() == elementType {
+			e := o.Interface().(Element)
+			if e.XMLName.Local != "" && !reflect.DeepEqual(o.Interface(), c.Interface()) {
+				lost(join(path, sep, e.XMLName.Local), strings.TrimSpace(e.InnerXML), "")
+			}
+			return
+		}
+		for i := 0; i < o.NumField(); i++ {
+			field := o.Type().Field(i)
+			if field.PkgPath != "" || field.Type == reflect.TypeOf(xml.Name{}) {
+				continue
+			}
+			name := field.Name
+			if x := strings.Split(field.Tag.Get("xml"), ",")[0]; x != "" {
+				name = x
+			}
+			if strings.Contains(field.Tag.Get("xml"), ",chardata") || field.Type == reflect.SliceOf(elementType) {
+				name = ""
+			}
+			compareValues(o.Field(i), c.Field(i), join(path, sep, name), sep, lost)
+		}
+	}
+}
+
+// join joins name to path with sep
+func join(path, sep, name string) string {
+	switch {
+	case path == "":
+		return name
+	case name == "":
+		return path
+	}
+	return path + sep + name
+}
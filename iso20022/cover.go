@@ -0,0 +1,264 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// The cover payment tags {7033} to {7072} hold the SWIFT fields of sequence B of an MT202 COV. These are the
+// SWIFT field tags written when converting to a FEDWireMessage, the option letter is added by the conversion.
+const (
+	swiftInstructedAmount        = "33B"
+	swiftOrderingCustomer        = "50"
+	swiftOrderingInstitution     = "52"
+	swiftIntermediaryInstitution = "56"
+	swiftAccountWithInstitution  = "57"
+	swiftBeneficiaryCustomer     = "59"
+	swiftRemittance              = "70"
+	swiftSenderToReceiver        = "72"
+)
+
+const (
+	// swiftFedwire prefixes the Fedwire routing number party identifier of a SWIFT field, e.g. //FW121042882
+	swiftFedwire = "//FW"
+	// swiftCHIPS prefixes the CHIPS participant party identifier of a SWIFT field, e.g. //CP0123
+	swiftCHIPS = "//CP"
+	// swiftOptionA is the option letter of a SWIFT field which identifies a party by BIC
+	swiftOptionA = "A"
+)
+
+// coverLines returns the lines of cp, without trailing empty lines
+func coverLines(cp wire.CoverPayment) []string {
+	lines := []string{
+		strings.TrimSpace(cp.SwiftLineOne), strings.TrimSpace(cp.SwiftLineTwo), strings.TrimSpace(cp.SwiftLineThree),
+		strings.TrimSpace(cp.SwiftLineFour), strings.TrimSpace(cp.SwiftLineFive), strings.TrimSpace(cp.SwiftLineSix),
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// coverPayment returns the CoverPayment of a SWIFT field tag and lines
+func coverPayment(swiftFieldTag string, lines []string) wire.CoverPayment {
+	lines = append(lines, make([]string, 6)...)
+	return wire.CoverPayment{
+		SwiftFieldTag:  swiftFieldTag,
+		SwiftLineOne:   lines[0],
+		SwiftLineTwo:   lines[1],
+		SwiftLineThree: lines[2],
+		SwiftLineFour:  lines[3],
+		SwiftLineFive:  lines[4],
+		SwiftLineSix:   lines[5],
+	}
+}
+
+// swiftParty returns the party, and account, of an ordering or beneficiary customer. A first line beginning
+// with "/" is the account, option A holds a BIC and other options hold a name and address.
+func swiftParty(cp wire.CoverPayment) (PartyIdentification, *CashAccount) {
+	var pty PartyIdentification
+	var acct *CashAccount
+	lines := coverLines(cp)
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "/") {
+		acct = &CashAccount{Id: AccountIdentification{Othr: &GenericIdentification{Id: lines[0][1:]}}}
+		lines = lines[1:]
+	}
+	if len(lines) == 0 {
+		return pty, acct
+	}
+	if strings.HasSuffix(strings.TrimSpace(cp.SwiftFieldTag), swiftOptionA) {
+		pty.Id = &Party{OrgId: &OrganisationIdentification{AnyBIC: lines[0]}}
+		return pty, acct
+	}
+	pty.Nm, pty.PstlAdr = lines[0], postalAddress(lines[1:]...)
+	return pty, acct
+}
+
+// partyCoverPayment returns the CoverPayment of an ordering or beneficiary customer, using option A when the
+// party is identified by BIC and option otherwise
+func partyCoverPayment(swiftFieldTag, option string, pty PartyIdentification, acct *CashAccount) wire.CoverPayment {
+	var lines []string
+	switch {
+	case acct != nil && acct.Id.IBAN != "":
+		lines = append(lines, "/"+acct.Id.IBAN)
+	case acct != nil && acct.Id.Othr != nil:
+		lines = append(lines, "/"+acct.Id.Othr.Id)
+	}
+	if pty.Id != nil && pty.Id.OrgId != nil && pty.Id.OrgId.AnyBIC != "" {
+		return coverPayment(swiftFieldTag+swiftOptionA, append(lines, pty.Id.OrgId.AnyBIC))
+	}
+	lines = append(lines, pty.Nm)
+	if pty.PstlAdr != nil {
+		lines = append(lines, pty.PstlAdr.AdrLine...)
+	}
+	return coverPayment(swiftFieldTag+option, lines)
+}
+
+// swiftAgent returns the agent of an ordering, intermediary or account with institution, or nil when cp is
+// empty. A first line beginning with "/" is the party identifier, option A holds a BIC and other options hold
+// a name and address.
+func swiftAgent(cp wire.CoverPayment) *BranchAndFinancialInstitutionIdentification {
+	lines := coverLines(cp)
+	if len(lines) == 0 {
+		return nil
+	}
+	var id FinancialInstitutionIdentification
+	switch {
+	case strings.HasPrefix(lines[0], swiftFedwire):
+		id.ClrSysMmbId = &ClearingSystemMemberIdentification{
+			ClrSysId: &CodeOrProprietary{Cd: clearingSystemABA},
+			MmbId:    strings.TrimPrefix(lines[0], swiftFedwire),
+		}
+		lines = lines[1:]
+	case strings.HasPrefix(lines[0], swiftCHIPS):
+		id.ClrSysMmbId = &ClearingSystemMemberIdentification{
+			ClrSysId: &CodeOrProprietary{Cd: clearingSystemCHIPS},
+			MmbId:    strings.TrimPrefix(lines[0], swiftCHIPS),
+		}
+		lines = lines[1:]
+	case strings.HasPrefix(lines[0], "/"):
+		id.Othr = &GenericIdentification{Id: lines[0][1:]}
+		lines = lines[1:]
+	}
+	switch {
+	case len(lines) == 0:
+	case strings.HasSuffix(strings.TrimSpace(cp.SwiftFieldTag), swiftOptionA):
+		id.BICFI = lines[0]
+	default:
+		id.Nm, id.PstlAdr = lines[0], postalAddress(lines[1:]...)
+	}
+	return &BranchAndFinancialInstitutionIdentification{FinInstnId: id}
+}
+
+// agentCoverPayment returns the CoverPayment of an institution, using option A when the institution is
+// identified by BIC and option D otherwise
+func agentCoverPayment(swiftFieldTag string, agt BranchAndFinancialInstitutionIdentification) wire.CoverPayment {
+	var lines []string
+	id := agt.FinInstnId
+	switch {
+	case id.ClrSysMmbId != nil && proprietaryValue(id.ClrSysMmbId.ClrSysId) == clearingSystemCHIPS:
+		lines = append(lines, swiftCHIPS+id.ClrSysMmbId.MmbId)
+	case id.ClrSysMmbId != nil:
+		lines = append(lines, swiftFedwire+id.ClrSysMmbId.MmbId)
+	case id.Othr != nil:
+		lines = append(lines, "/"+id.Othr.Id)
+	}
+	if id.BICFI != "" {
+		return coverPayment(swiftFieldTag+swiftOptionA, append(lines, id.BICFI))
+	}
+	lines = append(lines, id.Nm)
+	if id.PstlAdr != nil {
+		lines = append(lines, id.PstlAdr.AdrLine...)
+	}
+	return coverPayment(swiftFieldTag+"D", lines)
+}
+
+// isCoverPayment returns true when fwm is a CustomerTransferPlus (CTP) cover payment, which has the
+// LocalInstrument {3610} SequenceBCoverPaymentStructured (COVS)
+func isCoverPayment(fwm *wire.FEDWireMessage) bool {
+	return fwm.BusinessFunctionCode != nil && fwm.BusinessFunctionCode.BusinessFunctionCode == wire.CustomerTransferPlus &&
+		fwm.LocalInstrument != nil && fwm.LocalInstrument.LocalInstrumentCode == wire.SequenceBCoverPaymentStructured
+}
+
+// coverTransfer returns the underlying customer credit transfer of the cover payment tags {7033} to {7072},
+// or nil when fwm is not a cover payment. The debtor and creditor agents are mandatory, when fwm has no
+// OrderingInstitution {7052} or InstitutionAccount {7057} they are the debtor and creditor of tx.
+func coverTransfer(fwm *wire.FEDWireMessage, tx FinancialInstitutionCreditTransferTransaction) *UnderlyingCustomerCreditTransfer {
+	if !isCoverPayment(fwm) {
+		return nil
+	}
+	ucct := &UnderlyingCustomerCreditTransfer{DbtrAgt: tx.Dbtr, CdtrAgt: tx.Cdtr}
+	if oc := fwm.OrderingCustomer; oc != nil {
+		ucct.Dbtr, ucct.DbtrAcct = swiftParty(oc.CoverPayment)
+	}
+	if oi := fwm.OrderingInstitution; oi != nil {
+		if agt := swiftAgent(oi.CoverPayment); agt != nil {
+			ucct.DbtrAgt = *agt
+		}
+	}
+	if ii := fwm.IntermediaryInstitution; ii != nil {
+		ucct.IntrmyAgt1 = swiftAgent(ii.CoverPayment)
+	}
+	if ia := fwm.InstitutionAccount; ia != nil {
+		if agt := swiftAgent(ia.CoverPayment); agt != nil {
+			ucct.CdtrAgt = *agt
+		}
+	}
+	if bc := fwm.BeneficiaryCustomer; bc != nil {
+		ucct.Cdtr, ucct.CdtrAcct = swiftParty(bc.CoverPayment)
+	}
+	if str := fwm.SenderToReceiver; str != nil {
+		for _, line := range coverLines(str.CoverPayment) {
+			ucct.InstrForNxtAgt = append(ucct.InstrForNxtAgt, InstructionForNextAgent{InstrInf: line})
+		}
+	}
+	if ri := fwm.Remittance; ri != nil {
+		cp := ri.CoverPayment
+		if ustrd := unstructured(cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour); ustrd != nil {
+			ucct.RmtInf = &RemittanceInformation{Ustrd: ustrd}
+		}
+	}
+	if cia := fwm.CurrencyInstructedAmount; cia != nil {
+		amount := strings.TrimLeft(periodDecimal(cia.Amount), "0")
+		if strings.HasPrefix(amount, ".") || amount == "" {
+			amount = "0" + amount
+		}
+		ucct.InstdAmt = &ActiveCurrencyAndAmount{Ccy: "USD", Value: amount}
+	}
+	return ucct
+}
+
+// setCoverTransfer sets the cover payment tags {7033} to {7072} of fwm from the underlying customer credit
+// transfer of tx. Debtor and creditor agents which are the debtor and creditor of tx are not set.
+func setCoverTransfer(fwm *wire.FEDWireMessage, tx FinancialInstitutionCreditTransferTransaction) {
+	ucct := tx.UndrlygCstmrCdtTrf
+	if ucct == nil {
+		return
+	}
+	if ucct.InstdAmt != nil {
+		cia := wire.NewCurrencyInstructedAmount()
+		cia.SwiftFieldTag, cia.Amount = swiftInstructedAmount, commaDecimal(ucct.InstdAmt.Value)
+		fwm.SetCurrencyInstructedAmount(cia)
+	}
+	oc := wire.NewOrderingCustomer()
+	oc.CoverPayment = partyCoverPayment(swiftOrderingCustomer, "K", ucct.Dbtr, ucct.DbtrAcct)
+	fwm.SetOrderingCustomer(oc)
+	if !reflect.DeepEqual(ucct.DbtrAgt, tx.Dbtr) {
+		oi := wire.NewOrderingInstitution()
+		oi.CoverPayment = agentCoverPayment(swiftOrderingInstitution, ucct.DbtrAgt)
+		fwm.SetOrderingInstitution(oi)
+	}
+	if ucct.IntrmyAgt1 != nil {
+		ii := wire.NewIntermediaryInstitution()
+		ii.CoverPayment = agentCoverPayment(swiftIntermediaryInstitution, *ucct.IntrmyAgt1)
+		fwm.SetIntermediaryInstitution(ii)
+	}
+	if !reflect.DeepEqual(ucct.CdtrAgt, tx.Cdtr) {
+		ia := wire.NewInstitutionAccount()
+		ia.CoverPayment = agentCoverPayment(swiftAccountWithInstitution, ucct.CdtrAgt)
+		fwm.SetInstitutionAccount(ia)
+	}
+	bc := wire.NewBeneficiaryCustomer()
+	bc.CoverPayment = partyCoverPayment(swiftBeneficiaryCustomer, "", ucct.Cdtr, ucct.CdtrAcct)
+	fwm.SetBeneficiaryCustomer(bc)
+	if ucct.RmtInf != nil && len(ucct.RmtInf.Ustrd) > 0 {
+		ri := wire.NewRemittance()
+		ri.CoverPayment = coverPayment(swiftRemittance, unstructuredLines(ucct.RmtInf.Ustrd, 4))
+		fwm.SetRemittance(ri)
+	}
+	if len(ucct.InstrForNxtAgt) > 0 {
+		var lines []string
+		for i := range ucct.InstrForNxtAgt {
+			lines = append(lines, ucct.InstrForNxtAgt[i].InstrInf)
+		}
+		str := wire.NewSenderToReceiver()
+		str.CoverPayment = coverPayment(swiftSenderToReceiver, lines)
+		fwm.SetSenderToReceiver(str)
+	}
+}
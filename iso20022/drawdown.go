@@ -0,0 +1,156 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// paymentMethodTransfer is the payment method of a drawdown request, a credit transfer
+const paymentMethodTransfer = "TRF"
+
+// DateAndDateTime is either a date or a date time
+type DateAndDateTime struct {
+	Dt   string `xml:"Dt,omitempty"`
+	DtTm string `xml:"DtTm,omitempty"`
+}
+
+// AmountType is an amount of money
+type AmountType struct {
+	InstdAmt ActiveCurrencyAndAmount `xml:"InstdAmt"`
+}
+
+// drawdownParties are the parties of a drawdown request
+type drawdownParties struct {
+	Dbtr      PartyIdentification
+	DbtrAcct  *CashAccount
+	UltmtDbtr *PartyIdentification
+	Cdtr      PartyIdentification
+	CdtrAcct  *CashAccount
+}
+
+// drawdownMandatory checks fwm is a DrawDownRequest (DRW), BankDrawDownRequest (DRB) or
+// CustomerCorporateDrawdownRequest (DRC) with the tags an ISO 20022 message requires. A refusal is a
+// RefusalRequestCredit, which is not a request.
+func drawdownMandatory(fwm *wire.FEDWireMessage, refusal bool) error {
+	if fwm.BusinessFunctionCode == nil {
+		return &wire.FieldError{FieldName: "BusinessFunctionCode", Err: wire.ErrFieldRequired}
+	}
+	code := fwm.BusinessFunctionCode.BusinessFunctionCode
+	switch code {
+	case wire.DrawDownRequest, wire.BankDrawDownRequest, wire.CustomerCorporateDrawdownRequest:
+	default:
+		return &wire.FieldError{FieldName: "BusinessFunctionCode", Value: code, Err: ErrUnsupportedBusinessFunctionCode}
+	}
+	if fwm.TypeSubType == nil {
+		return &wire.FieldError{FieldName: "TypeSubType", Err: wire.ErrFieldRequired}
+	}
+	if (fwm.TypeSubType.SubTypeCode == wire.RefusalRequestCredit) != refusal {
+		return &wire.FieldError{FieldName: "TypeSubType", Value: fwm.TypeSubType.SubTypeCode,
+			Err: ErrUnsupportedBusinessFunctionCode}
+	}
+	return mandatory(fwm)
+}
+
+// drawdownCode returns the drawdown BusinessFunctionCode of a category purpose, DrawDownRequest (DRW) when it
+// is not a drawdown
+func drawdownCode(pti *PaymentTypeInformation) string {
+	if pti == nil {
+		return wire.DrawDownRequest
+	}
+	switch code := proprietaryValue(pti.CtgyPurp); code {
+	case wire.BankDrawDownRequest, wire.CustomerCorporateDrawdownRequest:
+		return code
+	}
+	return wire.DrawDownRequest
+}
+
+// drawdownTypeSubType returns the TypeCode and SubTypeCode of a drawdown request, or refusal, with a
+// BusinessFunctionCode. A DrawDownRequest (DRW) is a FundsTransferRequestCredit, bank drawdowns are settlement
+// transfers.
+func drawdownTypeSubType(code string, refusal bool) (string, string) {
+	typeCode := wire.FundsTransfer
+	if code == wire.BankDrawDownRequest {
+		typeCode = wire.SettlementTransfer
+	}
+	switch {
+	case refusal:
+		return typeCode, wire.RefusalRequestCredit
+	case code == wire.DrawDownRequest:
+		return typeCode, wire.FundsTransferRequestCredit
+	}
+	return typeCode, wire.RequestCredit
+}
+
+// parties returns the parties of a drawdown request. The debtor is AccountDebitedDrawdown {4400}, whose
+// Originator {5000} is the ultimate debtor, or the Originator when there is no AccountDebitedDrawdown. The
+// creditor is the Beneficiary {4200}, whose account is AccountCreditedDrawdown {5400} when there is one.
+func parties(fwm *wire.FEDWireMessage) drawdownParties {
+	var p drawdownParties
+	if o := fwm.Originator; o != nil {
+		dbtr, acct := party(o.Personal)
+		p.Dbtr, p.DbtrAcct = *dbtr, acct
+	}
+	if debitDD := fwm.AccountDebitedDrawdown; debitDD != nil {
+		if fwm.Originator != nil {
+			ultmtDbtr := p.Dbtr
+			p.UltmtDbtr = &ultmtDbtr
+		}
+		dbtr, acct := party(wire.Personal{
+			IdentificationCode: debitDD.IdentificationCode,
+			Identifier:         debitDD.Identifier,
+			Name:               debitDD.Name,
+			Address:            debitDD.Address,
+		})
+		p.Dbtr, p.DbtrAcct = *dbtr, acct
+	}
+	if b := fwm.Beneficiary; b != nil {
+		cdtr, acct := party(b.Personal)
+		p.Cdtr, p.CdtrAcct = *cdtr, acct
+	}
+	if creditDD := fwm.AccountCreditedDrawdown; creditDD != nil {
+		p.CdtrAcct = &CashAccount{Id: AccountIdentification{Othr: &GenericIdentification{
+			Id: strings.TrimSpace(creditDD.DrawdownCreditAccountNumber),
+		}}}
+	}
+	return p
+}
+
+// setParties sets the parties of a drawdown request of fwm, whose BusinessFunctionCode is set. A
+// BankDrawDownRequest (DRB) or CustomerCorporateDrawdownRequest (DRC) requires AccountDebitedDrawdown {4400}
+// and AccountCreditedDrawdown {5400}, a DrawDownRequest (DRW) uses the Originator {5000} and Beneficiary
+// {4200} accounts.
+func setParties(fwm *wire.FEDWireMessage, p drawdownParties) {
+	accounts := fwm.BusinessFunctionCode.BusinessFunctionCode != wire.DrawDownRequest
+	if accounts {
+		debitDD := wire.NewAccountDebitedDrawdown()
+		dbtr := personal(&p.Dbtr, p.DbtrAcct)
+		debitDD.IdentificationCode, debitDD.Identifier = dbtr.IdentificationCode, dbtr.Identifier
+		debitDD.Name, debitDD.Address = dbtr.Name, dbtr.Address
+		fwm.SetAccountDebitedDrawdown(debitDD)
+		if p.UltmtDbtr != nil {
+			o := wire.NewOriginator()
+			o.Personal = personal(p.UltmtDbtr, nil)
+			fwm.SetOriginator(o)
+		}
+		creditDD := wire.NewAccountCreditedDrawdown()
+		creditDD.DrawdownCreditAccountNumber = personal(nil, p.CdtrAcct).Identifier
+		fwm.SetAccountCreditedDrawdown(creditDD)
+	} else if !reflect.DeepEqual(p.Dbtr, PartyIdentification{}) || p.DbtrAcct != nil {
+		o := wire.NewOriginator()
+		o.Personal = personal(&p.Dbtr, p.DbtrAcct)
+		fwm.SetOriginator(o)
+	}
+	ben := wire.NewBeneficiary()
+	if accounts {
+		ben.Personal = personal(&p.Cdtr, nil)
+	} else {
+		ben.Personal = personal(&p.Cdtr, p.CdtrAcct)
+	}
+	fwm.SetBeneficiary(ben)
+}
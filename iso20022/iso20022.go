@@ -0,0 +1,196 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package iso20022 converts FEDWireMessages to and from the Fedwire flavour of ISO 20022 messages.
+//
+// Conversions report the data which could not be converted losslessly as Losses rather than failing, so
+// callers can decide whether a conversion is acceptable.
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// Loss describes data which could not be converted losslessly
+type Loss struct {
+	// Tag is the FEDWireMessage tag of the data, e.g. {6100}, when converting from a FEDWireMessage
+	Tag string `json:"tag,omitempty"`
+	// Element is the path of the ISO 20022 element, e.g. CdtTrfTxInf/Cdtr/Nm, when converting to a FEDWireMessage
+	Element string `json:"element,omitempty"`
+	// Field is the name of the field of the tag, or of the element
+	Field string `json:"field,omitempty"`
+	// Value is the original value
+	Value string `json:"value"`
+	// Converted is the value after converting it and back, empty when the value was dropped
+	Converted string `json:"converted,omitempty"`
+}
+
+func (l Loss) Error() string {
+	where := strings.TrimSpace(l.Tag + " " + l.Element)
+	if l.Field != "" {
+		where += " " + l.Field
+	}
+	if l.Converted == "" {
+		return fmt.Sprintf("%s %q can not be converted", where, l.Value)
+	}
+	return fmt.Sprintf("%s %q is converted to %q", where, l.Value, l.Converted)
+}
+
+// Losses is all the data which could not be converted losslessly
+type Losses []Loss
+
+func (l Losses) Error() string {
+	msgs := make([]string, len(l))
+	for i := range l {
+		msgs[i] = l[i].Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// ActiveCurrencyAndAmount is an amount of money in a currency
+type ActiveCurrencyAndAmount struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+// CodeOrProprietary is either an ISO 20022 code or a proprietary value
+type CodeOrProprietary struct {
+	Cd    string `xml:"Cd,omitempty"`
+	Prtry string `xml:"Prtry,omitempty"`
+}
+
+// SettlementInstruction describes how a payment is settled
+type SettlementInstruction struct {
+	SttlmMtd string             `xml:"SttlmMtd"`
+	ClrSys   *CodeOrProprietary `xml:"ClrSys,omitempty"`
+}
+
+// GroupHeader identifies a message
+type GroupHeader struct {
+	MsgId    string                `xml:"MsgId"`
+	CreDtTm  string                `xml:"CreDtTm"`
+	NbOfTxs  string                `xml:"NbOfTxs"`
+	SttlmInf SettlementInstruction `xml:"SttlmInf"`
+}
+
+// PaymentIdentification holds the references of a payment
+type PaymentIdentification struct {
+	InstrId    string `xml:"InstrId,omitempty"`
+	EndToEndId string `xml:"EndToEndId"`
+	UETR       string `xml:"UETR,omitempty"`
+}
+
+// PaymentTypeInformation describes the type of a payment
+type PaymentTypeInformation struct {
+	LclInstrm *CodeOrProprietary `xml:"LclInstrm,omitempty"`
+	CtgyPurp  *CodeOrProprietary `xml:"CtgyPurp,omitempty"`
+}
+
+// GenericIdentification is an identifier issued under an identification scheme
+type GenericIdentification struct {
+	Id      string             `xml:"Id"`
+	SchmeNm *CodeOrProprietary `xml:"SchmeNm,omitempty"`
+	Issr    string             `xml:"Issr,omitempty"`
+}
+
+// ClearingSystemMemberIdentification identifies a member of a clearing system, e.g. by ABA routing number
+type ClearingSystemMemberIdentification struct {
+	ClrSysId *CodeOrProprietary `xml:"ClrSysId,omitempty"`
+	MmbId    string             `xml:"MmbId"`
+}
+
+// PostalAddress is a structured or unstructured postal address
+type PostalAddress struct {
+	AdrTp       *CodeOrProprietary `xml:"AdrTp,omitempty"`
+	Dept        string             `xml:"Dept,omitempty"`
+	SubDept     string             `xml:"SubDept,omitempty"`
+	StrtNm      string             `xml:"StrtNm,omitempty"`
+	BldgNb      string             `xml:"BldgNb,omitempty"`
+	PstCd       string             `xml:"PstCd,omitempty"`
+	TwnNm       string             `xml:"TwnNm,omitempty"`
+	CtrySubDvsn string             `xml:"CtrySubDvsn,omitempty"`
+	Ctry        string             `xml:"Ctry,omitempty"`
+	AdrLine     []string           `xml:"AdrLine,omitempty"`
+}
+
+// FinancialInstitutionIdentification identifies a financial institution
+type FinancialInstitutionIdentification struct {
+	BICFI       string                              `xml:"BICFI,omitempty"`
+	ClrSysMmbId *ClearingSystemMemberIdentification `xml:"ClrSysMmbId,omitempty"`
+	Nm          string                              `xml:"Nm,omitempty"`
+	PstlAdr     *PostalAddress                      `xml:"PstlAdr,omitempty"`
+	Othr        *GenericIdentification              `xml:"Othr,omitempty"`
+}
+
+// BranchAndFinancialInstitutionIdentification identifies an agent
+type BranchAndFinancialInstitutionIdentification struct {
+	FinInstnId FinancialInstitutionIdentification `xml:"FinInstnId"`
+}
+
+// OrganisationIdentification identifies an organisation
+type OrganisationIdentification struct {
+	AnyBIC string                  `xml:"AnyBIC,omitempty"`
+	Othr   []GenericIdentification `xml:"Othr,omitempty"`
+}
+
+// PersonIdentification identifies a person
+type PersonIdentification struct {
+	Othr []GenericIdentification `xml:"Othr,omitempty"`
+}
+
+// Party identifies an organisation or a person
+type Party struct {
+	OrgId  *OrganisationIdentification `xml:"OrgId,omitempty"`
+	PrvtId *PersonIdentification       `xml:"PrvtId,omitempty"`
+}
+
+// OtherContact is a contact channel other than phone, fax or email
+type OtherContact struct {
+	ChanlTp string `xml:"ChanlTp"`
+	Id      string `xml:"Id,omitempty"`
+}
+
+// Contact is the contact details of a party
+type Contact struct {
+	Nm       string        `xml:"Nm,omitempty"`
+	PhneNb   string        `xml:"PhneNb,omitempty"`
+	MobNb    string        `xml:"MobNb,omitempty"`
+	FaxNb    string        `xml:"FaxNb,omitempty"`
+	EmailAdr string        `xml:"EmailAdr,omitempty"`
+	Othr     *OtherContact `xml:"Othr,omitempty"`
+}
+
+// PartyIdentification identifies a party, e.g. a debtor or creditor
+type PartyIdentification struct {
+	Nm        string         `xml:"Nm,omitempty"`
+	PstlAdr   *PostalAddress `xml:"PstlAdr,omitempty"`
+	Id        *Party         `xml:"Id,omitempty"`
+	CtryOfRes string         `xml:"CtryOfRes,omitempty"`
+	CtctDtls  *Contact       `xml:"CtctDtls,omitempty"`
+}
+
+// AccountIdentification identifies an account
+type AccountIdentification struct {
+	IBAN string                 `xml:"IBAN,omitempty"`
+	Othr *GenericIdentification `xml:"Othr,omitempty"`
+}
+
+// CashAccount is an account
+type CashAccount struct {
+	Id AccountIdentification `xml:"Id"`
+}
+
+// ChargesInformation is an amount of charges
+type ChargesInformation struct {
+	Amt ActiveCurrencyAndAmount                     `xml:"Amt"`
+	Agt BranchAndFinancialInstitutionIdentification `xml:"Agt"`
+}
+
+// Element is an XML element which is not supported by the conversion, it is kept so it can be reported
+type Element struct {
+	XMLName  xml.Name
+	InnerXML string `xml:",innerxml"`
+}
@@ -0,0 +1,400 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// Pacs008Namespace is the XML namespace of a pacs.008 FIToFICustomerCreditTransfer
+const Pacs008Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"
+
+var (
+	// ErrUnsupportedBusinessFunctionCode is returned when converting a FEDWireMessage of a BusinessFunctionCode
+	// the ISO 20022 message can not hold
+	ErrUnsupportedBusinessFunctionCode = errors.New("is not supported by the ISO 20022 message")
+	// ErrNoTransaction is returned when converting an ISO 20022 message with no transaction
+	ErrNoTransaction = errors.New("ISO 20022 message has no transaction")
+)
+
+// Pacs008 is a pacs.008 FIToFICustomerCreditTransfer document
+type Pacs008 struct {
+	XMLName           xml.Name                     `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08 Document"`
+	FIToFICstmrCdtTrf FIToFICustomerCreditTransfer `xml:"FIToFICstmrCdtTrf"`
+}
+
+// FIToFICustomerCreditTransfer is a customer credit transfer between financial institutions
+type FIToFICustomerCreditTransfer struct {
+	GrpHdr      GroupHeader                 `xml:"GrpHdr"`
+	CdtTrfTxInf []CreditTransferTransaction `xml:"CdtTrfTxInf"`
+}
+
+// CreditTransferTransaction is a single customer credit transfer
+type CreditTransferTransaction struct {
+	PmtId          PaymentIdentification                        `xml:"PmtId"`
+	PmtTpInf       *PaymentTypeInformation                      `xml:"PmtTpInf,omitempty"`
+	IntrBkSttlmAmt ActiveCurrencyAndAmount                      `xml:"IntrBkSttlmAmt"`
+	IntrBkSttlmDt  string                                       `xml:"IntrBkSttlmDt,omitempty"`
+	InstdAmt       *ActiveCurrencyAndAmount                     `xml:"InstdAmt,omitempty"`
+	XchgRate       string                                       `xml:"XchgRate,omitempty"`
+	ChrgBr         string                                       `xml:"ChrgBr,omitempty"`
+	ChrgsInf       []ChargesInformation                         `xml:"ChrgsInf,omitempty"`
+	PrvsInstgAgt1  *BranchAndFinancialInstitutionIdentification `xml:"PrvsInstgAgt1,omitempty"`
+	InstgAgt       BranchAndFinancialInstitutionIdentification  `xml:"InstgAgt"`
+	InstdAgt       BranchAndFinancialInstitutionIdentification  `xml:"InstdAgt"`
+	IntrmyAgt1     *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	Dbtr           PartyIdentification                          `xml:"Dbtr"`
+	DbtrAcct       *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DbtrAgt        BranchAndFinancialInstitutionIdentification  `xml:"DbtrAgt"`
+	CdtrAgt        BranchAndFinancialInstitutionIdentification  `xml:"CdtrAgt"`
+	Cdtr           PartyIdentification                          `xml:"Cdtr"`
+	CdtrAcct       *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+	RltdRmtInf     []RemittanceLocation                         `xml:"RltdRmtInf,omitempty"`
+	RmtInf         *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+	// Unsupported holds the elements which are not converted, so they can be reported
+	Unsupported []Element `xml:",any"`
+}
+
+// ReadPacs008 reads a pacs.008 document
+func ReadPacs008(r io.Reader) (*Pacs008, error) {
+	doc := &Pacs008{}
+	if err := xml.NewDecoder(r).Decode(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Write writes the document as XML
+func (doc *Pacs008) Write(w io.Writer) error {
+	return writeDocument(w, doc)
+}
+
+// writeDocument writes an ISO 20022 document as indented XML
+func writeDocument(w io.Writer, doc interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// chargeBearers are the ISO 20022 charge bearers of the Charges {3700} ChargeDetails
+var chargeBearers = map[string]string{
+	wire.CDBeneficiary: "CRED",
+	wire.CDShared:      "SHAR",
+}
+
+// ExportPacs008 converts a CustomerTransfer (CTR) or CustomerTransferPlus (CTP) FEDWireMessage to a pacs.008
+// document. The returned Losses describe every value of fwm which the document does not hold, such as the
+// FI to FI information tags {6100} to {6500}.
+func ExportPacs008(fwm *wire.FEDWireMessage) (*Pacs008, Losses, error) {
+	if err := customerTransferMandatory(fwm); err != nil {
+		return nil, nil, err
+	}
+	doc := pacs008(fwm)
+	doc.FIToFICstmrCdtTrf.GrpHdr.CreDtTm = creationDateTime()
+	return doc, messageLosses(normalized(fwm), importPacs008(doc)), nil
+}
+
+// ImportPacs008 converts a pacs.008 document to a FEDWireMessage. The returned Losses describe every element
+// of doc which the FEDWireMessage does not hold, such as transactions after the first or values which are too
+// long for their tag. The debtor is imported once, as the Originator {5000}, so a CustomerTransferPlus (CTP)
+// still needs the OriginatorOptionF {5010} it requires before it validates.
+func ImportPacs008(doc *Pacs008) (*wire.FEDWireMessage, Losses, error) {
+	if len(doc.FIToFICstmrCdtTrf.CdtTrfTxInf) == 0 {
+		return nil, nil, ErrNoTransaction
+	}
+	fwm := importPacs008(doc)
+	again := pacs008(fwm)
+	again.FIToFICstmrCdtTrf.GrpHdr.CreDtTm = doc.FIToFICstmrCdtTrf.GrpHdr.CreDtTm
+	return fwm, documentLosses(doc, again), nil
+}
+
+// customerTransferMandatory checks fwm is a customer transfer with the tags a pacs.008 requires
+func customerTransferMandatory(fwm *wire.FEDWireMessage) error {
+	if fwm.BusinessFunctionCode == nil {
+		return &wire.FieldError{FieldName: "BusinessFunctionCode", Err: wire.ErrFieldRequired}
+	}
+	switch code := fwm.BusinessFunctionCode.BusinessFunctionCode; code {
+	case wire.CustomerTransfer, wire.CustomerTransferPlus:
+	default:
+		return &wire.FieldError{FieldName: "BusinessFunctionCode", Value: code, Err: ErrUnsupportedBusinessFunctionCode}
+	}
+	return mandatory(fwm)
+}
+
+// mandatory checks the mandatory tags of fwm are defined
+func mandatory(fwm *wire.FEDWireMessage) error {
+	if fwm.InputMessageAccountabilityData == nil {
+		return &wire.FieldError{FieldName: "InputMessageAccountabilityData", Err: wire.ErrFieldRequired}
+	}
+	if fwm.Amount == nil {
+		return &wire.FieldError{FieldName: "Amount", Err: wire.ErrFieldRequired}
+	}
+	if fwm.SenderDepositoryInstitution == nil {
+		return &wire.FieldError{FieldName: "SenderDepositoryInstitution", Err: wire.ErrFieldRequired}
+	}
+	if fwm.ReceiverDepositoryInstitution == nil {
+		return &wire.FieldError{FieldName: "ReceiverDepositoryInstitution", Err: wire.ErrFieldRequired}
+	}
+	return nil
+}
+
+// groupHeader returns the group header of fwm, which is identified by its IMAD
+func groupHeader(fwm *wire.FEDWireMessage) GroupHeader {
+	imad := fwm.InputMessageAccountabilityData
+	return GroupHeader{
+		MsgId:   imad.InputCycleDateField() + imad.InputSourceField() + imad.InputSequenceNumberField(),
+		NbOfTxs: "1",
+		SttlmInf: SettlementInstruction{
+			SttlmMtd: settlementMethodClearing,
+			ClrSys:   &CodeOrProprietary{Cd: clearingSystemFedwire},
+		},
+	}
+}
+
+// setGroupHeader sets the mandatory tags {1500}, {1510} and {1520} of fwm from a group header. ISO 20022
+// has no UserRequestCorrelation, it is set to the InputSequenceNumber.
+func setGroupHeader(fwm *wire.FEDWireMessage, hdr GroupHeader, typeCode, subTypeCode string) {
+	tst := wire.NewTypeSubType()
+	tst.TypeCode, tst.SubTypeCode = typeCode, subTypeCode
+	fwm.SetTypeSubType(tst)
+	imad := wire.NewInputMessageAccountabilityData()
+	if id := hdr.MsgId; len(id) == 22 {
+		imad.InputCycleDate, imad.InputSource, imad.InputSequenceNumber = id[:8], id[8:16], id[16:]
+	} else {
+		imad.InputSource = id
+	}
+	fwm.SetInputMessageAccountabilityData(imad)
+	ss := wire.NewSenderSupplied()
+	ss.UserRequestCorrelation = imad.InputSequenceNumber
+	fwm.SetSenderSupplied(ss)
+}
+
+// pacs008 returns the pacs.008 document of fwm, without a creation date time
+func pacs008(fwm *wire.FEDWireMessage) *Pacs008 {
+	tx := CreditTransferTransaction{
+		PmtId:          paymentIdentification(fwm),
+		PmtTpInf:       paymentTypeInformation(fwm),
+		IntrBkSttlmAmt: ActiveCurrencyAndAmount{Ccy: "USD", Value: decimalAmount(fwm.Amount.Amount)},
+		IntrBkSttlmDt:  isoDate(fwm.InputMessageAccountabilityData.InputCycleDate),
+		InstgAgt:       abaAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName),
+		InstdAgt:       abaAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName),
+		RltdRmtInf:     relatedRemittance(fwm),
+		RmtInf:         remittanceInformation(fwm),
+	}
+	if c := fwm.Charges; c != nil {
+		tx.ChrgBr = chargeBearers[c.ChargeDetails]
+		for _, charges := range []string{c.SendersChargesOne, c.SendersChargesTwo, c.SendersChargesThree, c.SendersChargesFour} {
+			if charges = strings.TrimSpace(charges); len(charges) > 3 {
+				tx.ChrgsInf = append(tx.ChrgsInf, ChargesInformation{
+					Amt: ActiveCurrencyAndAmount{Ccy: charges[:3], Value: periodDecimal(charges[3:])},
+					Agt: tx.InstgAgt,
+				})
+			}
+		}
+	}
+	if ia := fwm.InstructedAmount; ia != nil {
+		tx.InstdAmt = &ActiveCurrencyAndAmount{Ccy: ia.CurrencyCode, Value: periodDecimal(ia.Amount)}
+	}
+	if er := fwm.ExchangeRate; er != nil {
+		tx.XchgRate = periodDecimal(er.ExchangeRate)
+	}
+	if fi := fwm.InstructingFI; fi != nil {
+		tx.PrvsInstgAgt1 = agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.BeneficiaryIntermediaryFI; fi != nil {
+		tx.IntrmyAgt1 = agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.BeneficiaryFI; fi != nil {
+		if agt := agent(fi.FinancialInstitution); agt != nil {
+			tx.CdtrAgt = *agt
+		}
+	}
+	if fi := fwm.OriginatorFI; fi != nil {
+		if agt := agent(fi.FinancialInstitution); agt != nil {
+			tx.DbtrAgt = *agt
+		}
+	}
+	if o := fwm.Originator; o != nil {
+		dbtr, acct := party(o.Personal)
+		tx.Dbtr, tx.DbtrAcct = *dbtr, acct
+	} else if oof := fwm.OriginatorOptionF; oof != nil {
+		dbtr, acct := optionFParty(oof)
+		tx.Dbtr, tx.DbtrAcct = *dbtr, acct
+	}
+	if b := fwm.Beneficiary; b != nil {
+		cdtr, acct := party(b.Personal)
+		tx.Cdtr, tx.CdtrAcct = *cdtr, acct
+	}
+	return &Pacs008{
+		FIToFICstmrCdtTrf: FIToFICustomerCreditTransfer{
+			GrpHdr:      groupHeader(fwm),
+			CdtTrfTxInf: []CreditTransferTransaction{tx},
+		},
+	}
+}
+
+// paymentIdentification returns the references of fwm, SenderReference {3320} and BeneficiaryReference {4320}
+func paymentIdentification(fwm *wire.FEDWireMessage) PaymentIdentification {
+	pmtID := PaymentIdentification{EndToEndId: notProvided}
+	if sr := fwm.SenderReference; sr != nil {
+		pmtID.InstrId = strings.TrimSpace(sr.SenderReference)
+	}
+	if br := fwm.BeneficiaryReference; br != nil && strings.TrimSpace(br.BeneficiaryReference) != "" {
+		pmtID.EndToEndId = strings.TrimSpace(br.BeneficiaryReference)
+	}
+	return pmtID
+}
+
+// setPaymentIdentification sets SenderReference {3320} and BeneficiaryReference {4320} of fwm
+func setPaymentIdentification(fwm *wire.FEDWireMessage, pmtID PaymentIdentification) {
+	if pmtID.InstrId != "" {
+		sr := wire.NewSenderReference()
+		sr.SenderReference = pmtID.InstrId
+		fwm.SetSenderReference(sr)
+	}
+	if pmtID.EndToEndId != "" && pmtID.EndToEndId != notProvided {
+		br := wire.NewBeneficiaryReference()
+		br.BeneficiaryReference = pmtID.EndToEndId
+		fwm.SetBeneficiaryReference(br)
+	}
+}
+
+// paymentTypeInformation returns the BusinessFunctionCode {3600} of fwm as the category purpose and its
+// LocalInstrument {3610} as the local instrument
+func paymentTypeInformation(fwm *wire.FEDWireMessage) *PaymentTypeInformation {
+	pti := &PaymentTypeInformation{
+		CtgyPurp: proprietary(fwm.BusinessFunctionCode.BusinessFunctionCode),
+	}
+	if li := fwm.LocalInstrument; li != nil {
+		code := li.LocalInstrumentCode
+		if code == wire.ProprietaryLocalInstrumentCode {
+			code = li.ProprietaryCode
+		}
+		pti.LclInstrm = proprietary(code)
+	}
+	return pti
+}
+
+// setLocalInstrument sets LocalInstrument {3610} of fwm from the local instrument of pti
+func setLocalInstrument(fwm *wire.FEDWireMessage, pti *PaymentTypeInformation) {
+	if pti == nil {
+		return
+	}
+	code := proprietaryValue(pti.LclInstrm)
+	if code == "" {
+		return
+	}
+	li := wire.NewLocalInstrument()
+	li.LocalInstrumentCode = wire.ProprietaryLocalInstrumentCode
+	li.ProprietaryCode = code
+	for _, c := range localInstrumentCodes {
+		if c == code {
+			li.LocalInstrumentCode, li.ProprietaryCode = code, ""
+		}
+	}
+	fwm.SetLocalInstrument(li)
+}
+
+// setSettlement sets Amount {2000}, SenderDepositoryInstitution {3100} and ReceiverDepositoryInstitution {3400}
+// of fwm
+func setSettlement(fwm *wire.FEDWireMessage, amount ActiveCurrencyAndAmount, instgAgt, instdAgt BranchAndFinancialInstitutionIdentification) {
+	amt := wire.NewAmount()
+	amt.Amount = centsAmount(amount.Value)
+	fwm.SetAmount(amt)
+	sdi := wire.NewSenderDepositoryInstitution()
+	sdi.SenderABANumber, sdi.SenderShortName = abaNumber(instgAgt)
+	fwm.SetSenderDepositoryInstitution(sdi)
+	rdi := wire.NewReceiverDepositoryInstitution()
+	rdi.ReceiverABANumber, rdi.ReceiverShortName = abaNumber(instdAgt)
+	fwm.SetReceiverDepositoryInstitution(rdi)
+}
+
+// localInstrumentCodes are the LocalInstrument {3610} LocalInstrumentCodes, other codes are proprietary
+var localInstrumentCodes = []string{
+	wire.ANSIX12format, wire.SequenceBCoverPaymentStructured, wire.GeneralXMLformat, wire.ISO20022XMLformat,
+	wire.NarrativeText, wire.RemittanceInformationStructured, wire.RelatedRemittanceInformation, wire.STP820format,
+	wire.SWIFTfield70, wire.UNEDIFACTformat,
+}
+
+// importPacs008 returns the FEDWireMessage of the first transaction of doc
+func importPacs008(doc *Pacs008) *wire.FEDWireMessage {
+	fwm := wire.NewFEDWireMessage()
+	hdr, tx := doc.FIToFICstmrCdtTrf.GrpHdr, doc.FIToFICstmrCdtTrf.CdtTrfTxInf[0]
+	setGroupHeader(&fwm, hdr, wire.FundsTransfer, wire.BasicFundsTransfer)
+
+	setSettlement(&fwm, tx.IntrBkSttlmAmt, tx.InstgAgt, tx.InstdAgt)
+
+	bfc := wire.NewBusinessFunctionCode()
+	bfc.BusinessFunctionCode = wire.CustomerTransfer
+	if tx.PmtTpInf != nil && proprietaryValue(tx.PmtTpInf.CtgyPurp) == wire.CustomerTransferPlus {
+		bfc.BusinessFunctionCode = wire.CustomerTransferPlus
+	}
+	fwm.SetBusinessFunctionCode(bfc)
+
+	setPaymentIdentification(&fwm, tx.PmtId)
+	setLocalInstrument(&fwm, tx.PmtTpInf)
+	if tx.ChrgBr != "" || len(tx.ChrgsInf) > 0 {
+		c := wire.NewCharges()
+		for details, bearer := range chargeBearers {
+			if bearer == tx.ChrgBr {
+				c.ChargeDetails = details
+			}
+		}
+		charges := make([]string, 4)
+		for i := 0; i < len(tx.ChrgsInf) && i < len(charges); i++ {
+			charges[i] = tx.ChrgsInf[i].Amt.Ccy + commaDecimal(tx.ChrgsInf[i].Amt.Value)
+		}
+		c.SendersChargesOne, c.SendersChargesTwo, c.SendersChargesThree, c.SendersChargesFour =
+			charges[0], charges[1], charges[2], charges[3]
+		fwm.SetCharges(c)
+	}
+	if tx.InstdAmt != nil {
+		ia := wire.NewInstructedAmount()
+		ia.CurrencyCode, ia.Amount = tx.InstdAmt.Ccy, commaDecimal(tx.InstdAmt.Value)
+		fwm.SetInstructedAmount(ia)
+	}
+	if tx.XchgRate != "" {
+		er := wire.NewExchangeRate()
+		er.ExchangeRate = commaDecimal(tx.XchgRate)
+		fwm.SetExchangeRate(er)
+	}
+	if tx.IntrmyAgt1 != nil {
+		fi := wire.NewBeneficiaryIntermediaryFI()
+		fi.FinancialInstitution = financialInstitution(tx.IntrmyAgt1)
+		fwm.SetBeneficiaryIntermediaryFI(fi)
+	}
+	bfi := wire.NewBeneficiaryFI()
+	bfi.FinancialInstitution = financialInstitution(&tx.CdtrAgt)
+	fwm.SetBeneficiaryFI(bfi)
+	ben := wire.NewBeneficiary()
+	ben.Personal = personal(&tx.Cdtr, tx.CdtrAcct)
+	fwm.SetBeneficiary(ben)
+	o := wire.NewOriginator()
+	o.Personal = personal(&tx.Dbtr, tx.DbtrAcct)
+	fwm.SetOriginator(o)
+	ofi := wire.NewOriginatorFI()
+	ofi.FinancialInstitution = financialInstitution(&tx.DbtrAgt)
+	fwm.SetOriginatorFI(ofi)
+	if tx.PrvsInstgAgt1 != nil {
+		fi := wire.NewInstructingFI()
+		fi.FinancialInstitution = financialInstitution(tx.PrvsInstgAgt1)
+		fwm.SetInstructingFI(fi)
+	}
+	setRelatedRemittance(&fwm, tx.RltdRmtInf)
+	setRemittanceInformation(&fwm, tx.RmtInf)
+	return normalized(&fwm)
+}
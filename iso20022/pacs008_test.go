@@ -0,0 +1,198 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+// readMessage reads the first FEDWireMessage of a test file
+func readMessage(t *testing.T, name string) *wire.FEDWireMessage {
+	t.Helper()
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	file, err := wire.NewReader(fd).Read()
+	if err != nil {
+		t.Fatalf("%s: %T: %s", name, err, err)
+	}
+	return file.FEDWireMessage()
+}
+
+// lossTags returns the tags of losses
+func lossTags(losses Losses) map[string]bool {
+	tags := make(map[string]bool)
+	for i := range losses {
+		tags[losses[i].Tag] = true
+	}
+	return tags
+}
+
+// checkImported checks fwm validates, but for the OriginatorOptionF {5010} a CTP requires, as the debtor is
+// imported once as the Originator {5000}
+func checkImported(t *testing.T, name string, fwm *wire.FEDWireMessage) {
+	t.Helper()
+	if fwm.Originator != nil && fwm.OriginatorOptionF != nil {
+		t.Errorf("%s: the debtor is both the Originator and OriginatorOptionF", name)
+	}
+	errs := fwm.ValidateAll()
+	if fwm.BusinessFunctionCode.BusinessFunctionCode == wire.CustomerTransferPlus {
+		if len(errs) != 1 || errs[0].Tag != wire.TagOriginatorOptionF || errs[0].Rule != wire.ErrFieldRequired.Error() {
+			t.Errorf("%s: imported CTP should only miss {5010}:\n%s", name, errs)
+		}
+		return
+	}
+	if len(errs) != 0 {
+		t.Errorf("%s: imported message is invalid:\n%s", name, errs)
+	}
+}
+
+// TestPacs008_RoundTrip exports customer transfers, writes and reads the XML and imports it back
+func TestPacs008_RoundTrip(t *testing.T) {
+	for name, lost := range map[string][]string{
+		"fedWireMessage-CustomerTransfer.txt": {
+			wire.TagSenderSupplied, wire.TagPreviousMessageIdentifier, wire.TagFIReceiverFI,
+			wire.TagFIIntermediaryFI, wire.TagFIIntermediaryFIAdvice, wire.TagFIBeneficiaryFI,
+			wire.TagFIBeneficiaryFIAdvice, wire.TagFIBeneficiary, wire.TagFIBeneficiaryAdvice,
+			wire.TagFIPaymentMethodToBeneficiary, wire.TagFIAdditionalFIToFI,
+		},
+		"fedWireMessage-CustomerTransferPlusStructuredRemittance.txt": {
+			wire.TagSenderSupplied, wire.TagPreviousMessageIdentifier, wire.TagPaymentNotification,
+			wire.TagOriginatorOptionF, wire.TagFIIntermediaryFI, wire.TagFIIntermediaryFIAdvice,
+			wire.TagFIBeneficiaryFI, wire.TagFIBeneficiaryFIAdvice, wire.TagFIBeneficiary,
+			wire.TagFIBeneficiaryAdvice, wire.TagFIPaymentMethodToBeneficiary, wire.TagFIAdditionalFIToFI,
+		},
+	} {
+		fwm := readMessage(t, name)
+		doc, losses, err := ExportPacs008(fwm)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		tags := lossTags(losses)
+		if len(tags) != len(lost) {
+			t.Errorf("%s: unexpected losses:\n%s", name, losses)
+		}
+		for _, tag := range lost {
+			if !tags[tag] {
+				t.Errorf("%s: %s is not reported lost", name, tag)
+			}
+		}
+
+		var buf bytes.Buffer
+		if err := doc.Write(&buf); err != nil {
+			t.Fatal(err)
+		}
+		read, err := ReadPacs008(&buf)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		imported, importLosses, err := ImportPacs008(read)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		if len(importLosses) != 0 {
+			t.Errorf("%s: unexpected import losses:\n%s", name, importLosses)
+		}
+		if got := messageLosses(normalized(fwm), imported); len(got) != len(losses) {
+			t.Errorf("%s: imported message differs:\n%s", name, got)
+		}
+		checkImported(t, name, imported)
+	}
+}
+
+// TestExportPacs008_BusinessFunctionCode validates only customer transfers are exported
+func TestExportPacs008_BusinessFunctionCode(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-BankTransfer.txt")
+	if _, _, err := ExportPacs008(fwm); !base.Match(err, ErrUnsupportedBusinessFunctionCode) {
+		t.Errorf("%T: %s", err, err)
+	}
+
+	fwm.BusinessFunctionCode = nil
+	if _, _, err := ExportPacs008(fwm); !base.Match(err, wire.ErrFieldRequired) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestImportPacs008_Losses validates elements a FEDWireMessage can not hold are reported
+func TestImportPacs008_Losses(t *testing.T) {
+	doc, err := ReadPacs008(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08">
+  <FIToFICstmrCdtTrf>
+    <GrpHdr>
+      <MsgId>20190410Source08000001</MsgId>
+      <CreDtTm>2019-04-10T10:00:00Z</CreDtTm>
+      <NbOfTxs>2</NbOfTxs>
+      <SttlmInf><SttlmMtd>CLRG</SttlmMtd><ClrSys><Cd>FDW</Cd></ClrSys></SttlmInf>
+    </GrpHdr>
+    <CdtTrfTxInf>
+      <PmtId>
+        <EndToEndId>NOTPROVIDED</EndToEndId>
+        <UETR>8a562c67-ca16-48ba-b074-65581be6f011</UETR>
+      </PmtId>
+      <PmtTpInf><CtgyPurp><Prtry>CTP</Prtry></CtgyPurp></PmtTpInf>
+      <IntrBkSttlmAmt Ccy="USD">12345.67</IntrBkSttlmAmt>
+      <InstgAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>121042882</MmbId></ClrSysMmbId></FinInstnId></InstgAgt>
+      <InstdAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>231380104</MmbId></ClrSysMmbId></FinInstnId></InstdAgt>
+      <Dbtr><Nm>Debtor</Nm></Dbtr>
+      <DbtrAcct><Id><Othr><Id>123456789</Id></Othr></Id></DbtrAcct>
+      <DbtrAgt><FinInstnId><BICFI>WFBIUS6SXXX</BICFI></FinInstnId></DbtrAgt>
+      <CdtrAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>231380104</MmbId></ClrSysMmbId></FinInstnId></CdtrAgt>
+      <Cdtr><Nm>A Creditor Name Which Is Longer Than Thirty Five Characters</Nm></Cdtr>
+      <CdtrAcct><Id><Othr><Id>987654321</Id></Othr></Id></CdtrAcct>
+      <Purp><Cd>SALA</Cd></Purp>
+    </CdtTrfTxInf>
+    <CdtTrfTxInf>
+      <PmtId><EndToEndId>Second</EndToEndId></PmtId>
+      <IntrBkSttlmAmt Ccy="USD">1.00</IntrBkSttlmAmt>
+    </CdtTrfTxInf>
+  </FIToFICstmrCdtTrf>
+</Document>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fwm, losses, err := ImportPacs008(doc)
+	if err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if fwm.Amount.Amount != "000001234567" || fwm.Beneficiary.Personal.Identifier != "987654321" {
+		t.Errorf("unexpected FEDWireMessage %#v", fwm)
+	}
+	if fwm.BusinessFunctionCode.BusinessFunctionCode != wire.CustomerTransferPlus {
+		t.Errorf("BusinessFunctionCode=%q", fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	// the debtor is imported once, as the Originator {5000}
+	if fwm.Originator == nil || fwm.Originator.Personal.Name != "Debtor" || fwm.OriginatorOptionF != nil {
+		t.Errorf("unexpected Originator %v and OriginatorOptionF %v", fwm.Originator, fwm.OriginatorOptionF)
+	}
+
+	for _, element := range []string{
+		"GrpHdr/NbOfTxs",
+		"CdtTrfTxInf[0]/PmtId/UETR",
+		"CdtTrfTxInf[0]/Cdtr/Nm",
+		"CdtTrfTxInf[0]/Purp",
+		"CdtTrfTxInf[1]/PmtId/EndToEndId",
+	} {
+		found := false
+		for i := range losses {
+			found = found || strings.HasSuffix(losses[i].Element, element)
+		}
+		if !found {
+			t.Errorf("%s is not reported lost in\n%s", element, losses)
+		}
+	}
+
+	if _, _, err := ImportPacs008(&Pacs008{}); err != ErrNoTransaction {
+		t.Errorf("%T: %s", err, err)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"io"
+	"reflect"
+
+	"github.com/moov-io/wire"
+)
+
+// Pacs009Namespace is the XML namespace of a pacs.009 FinancialInstitutionCreditTransfer, both core and COV
+const Pacs009Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08"
+
+// Pacs009 is a pacs.009 FinancialInstitutionCreditTransfer document. It is a pacs.009 COV when its transaction
+// has an underlying customer credit transfer.
+type Pacs009 struct {
+	XMLName  xml.Name                           `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08 Document"`
+	FICdtTrf FinancialInstitutionCreditTransfer `xml:"FICdtTrf"`
+}
+
+// FinancialInstitutionCreditTransfer is a credit transfer between financial institutions
+type FinancialInstitutionCreditTransfer struct {
+	GrpHdr      GroupHeader                                     `xml:"GrpHdr"`
+	CdtTrfTxInf []FinancialInstitutionCreditTransferTransaction `xml:"CdtTrfTxInf"`
+}
+
+// FinancialInstitutionCreditTransferTransaction is a single financial institution credit transfer
+type FinancialInstitutionCreditTransferTransaction struct {
+	PmtId              PaymentIdentification                        `xml:"PmtId"`
+	PmtTpInf           *PaymentTypeInformation                      `xml:"PmtTpInf,omitempty"`
+	IntrBkSttlmAmt     ActiveCurrencyAndAmount                      `xml:"IntrBkSttlmAmt"`
+	IntrBkSttlmDt      string                                       `xml:"IntrBkSttlmDt,omitempty"`
+	PrvsInstgAgt1      *BranchAndFinancialInstitutionIdentification `xml:"PrvsInstgAgt1,omitempty"`
+	InstgAgt           BranchAndFinancialInstitutionIdentification  `xml:"InstgAgt"`
+	InstdAgt           BranchAndFinancialInstitutionIdentification  `xml:"InstdAgt"`
+	IntrmyAgt1         *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	Dbtr               BranchAndFinancialInstitutionIdentification  `xml:"Dbtr"`
+	DbtrAgt            *BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt,omitempty"`
+	CdtrAgt            *BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt,omitempty"`
+	Cdtr               BranchAndFinancialInstitutionIdentification  `xml:"Cdtr"`
+	RmtInf             *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+	UndrlygCstmrCdtTrf *UnderlyingCustomerCreditTransfer            `xml:"UndrlygCstmrCdtTrf,omitempty"`
+	// Unsupported holds the elements which are not converted, so they can be reported
+	Unsupported []Element `xml:",any"`
+}
+
+// UnderlyingCustomerCreditTransfer is the customer credit transfer covered by a pacs.009 COV
+type UnderlyingCustomerCreditTransfer struct {
+	Dbtr           PartyIdentification                          `xml:"Dbtr"`
+	DbtrAcct       *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DbtrAgt        BranchAndFinancialInstitutionIdentification  `xml:"DbtrAgt"`
+	IntrmyAgt1     *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	CdtrAgt        BranchAndFinancialInstitutionIdentification  `xml:"CdtrAgt"`
+	Cdtr           PartyIdentification                          `xml:"Cdtr"`
+	CdtrAcct       *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+	InstrForNxtAgt []InstructionForNextAgent                    `xml:"InstrForNxtAgt,omitempty"`
+	RmtInf         *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+	InstdAmt       *ActiveCurrencyAndAmount                     `xml:"InstdAmt,omitempty"`
+	// Unsupported holds the elements which are not converted, so they can be reported
+	Unsupported []Element `xml:",any"`
+}
+
+// InstructionForNextAgent is an instruction for the next agent of a payment
+type InstructionForNextAgent struct {
+	InstrInf string `xml:"InstrInf,omitempty"`
+}
+
+// ReadPacs009 reads a pacs.009 document
+func ReadPacs009(r io.Reader) (*Pacs009, error) {
+	doc := &Pacs009{}
+	if err := xml.NewDecoder(r).Decode(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Write writes the document as XML
+func (doc *Pacs009) Write(w io.Writer) error {
+	return writeDocument(w, doc)
+}
+
+// ExportPacs009 converts a BankTransfer (BTR), FEDFundsSold (FFS) or FEDFundsReturned (FFR) FEDWireMessage to a
+// pacs.009 core document, and a CustomerTransferPlus (CTP) cover payment (COVS) to a pacs.009 COV document whose
+// underlying customer credit transfer holds the cover payment tags {7033} to {7072}. The returned Losses
+// describe every value of fwm which the document does not hold.
+func ExportPacs009(fwm *wire.FEDWireMessage) (*Pacs009, Losses, error) {
+	if err := financialInstitutionTransferMandatory(fwm); err != nil {
+		return nil, nil, err
+	}
+	doc := pacs009(fwm)
+	doc.FICdtTrf.GrpHdr.CreDtTm = creationDateTime()
+	return doc, messageLosses(normalized(fwm), importPacs009(doc)), nil
+}
+
+// ImportPacs009 converts a pacs.009 core or COV document to a FEDWireMessage. The returned Losses describe every
+// element of doc which the FEDWireMessage does not hold. The debtor of a COV is imported once, as the Originator
+// {5000}, so the CustomerTransferPlus (CTP) still needs the OriginatorOptionF {5010} it requires before it validates.
+func ImportPacs009(doc *Pacs009) (*wire.FEDWireMessage, Losses, error) {
+	if len(doc.FICdtTrf.CdtTrfTxInf) == 0 {
+		return nil, nil, ErrNoTransaction
+	}
+	fwm := importPacs009(doc)
+	again := pacs009(fwm)
+	again.FICdtTrf.GrpHdr.CreDtTm = doc.FICdtTrf.GrpHdr.CreDtTm
+	return fwm, documentLosses(doc, again), nil
+}
+
+// financialInstitutionTransferMandatory checks fwm is a bank transfer, or a cover payment, with the tags a
+// pacs.009 requires
+func financialInstitutionTransferMandatory(fwm *wire.FEDWireMessage) error {
+	if fwm.BusinessFunctionCode == nil {
+		return &wire.FieldError{FieldName: "BusinessFunctionCode", Err: wire.ErrFieldRequired}
+	}
+	switch code := fwm.BusinessFunctionCode.BusinessFunctionCode; code {
+	case wire.BankTransfer, wire.FEDFundsSold, wire.FEDFundsReturned:
+	case wire.CustomerTransferPlus:
+		if !isCoverPayment(fwm) {
+			return &wire.FieldError{FieldName: "LocalInstrument", Err: ErrUnsupportedBusinessFunctionCode,
+				Msg: "only CustomerTransferPlus cover payments (COVS) are supported by pacs.009"}
+		}
+	default:
+		return &wire.FieldError{FieldName: "BusinessFunctionCode", Value: code, Err: ErrUnsupportedBusinessFunctionCode}
+	}
+	return mandatory(fwm)
+}
+
+// pacs009 returns the pacs.009 document of fwm, without a creation date time. The debtor and creditor are
+// mandatory, when fwm has no Originator {5000} or Beneficiary {4200} they are the instructing and instructed
+// agents.
+func pacs009(fwm *wire.FEDWireMessage) *Pacs009 {
+	tx := FinancialInstitutionCreditTransferTransaction{
+		PmtId:          paymentIdentification(fwm),
+		PmtTpInf:       paymentTypeInformation(fwm),
+		IntrBkSttlmAmt: ActiveCurrencyAndAmount{Ccy: "USD", Value: decimalAmount(fwm.Amount.Amount)},
+		IntrBkSttlmDt:  isoDate(fwm.InputMessageAccountabilityData.InputCycleDate),
+		InstgAgt:       abaAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName),
+		InstdAgt:       abaAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName),
+	}
+	tx.Dbtr, tx.Cdtr = tx.InstgAgt, tx.InstdAgt
+	if fi := fwm.InstructingFI; fi != nil {
+		tx.PrvsInstgAgt1 = agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.BeneficiaryIntermediaryFI; fi != nil {
+		tx.IntrmyAgt1 = agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.BeneficiaryFI; fi != nil {
+		tx.CdtrAgt = agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.OriginatorFI; fi != nil {
+		tx.DbtrAgt = agent(fi.FinancialInstitution)
+	}
+	if o := fwm.Originator; o != nil {
+		if agt := agent(wire.FinancialInstitution(o.Personal)); agt != nil {
+			tx.Dbtr = *agt
+		}
+	}
+	if b := fwm.Beneficiary; b != nil {
+		if agt := agent(wire.FinancialInstitution(b.Personal)); agt != nil {
+			tx.Cdtr = *agt
+		}
+	}
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		if ustrd := unstructured(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour); ustrd != nil {
+			tx.RmtInf = &RemittanceInformation{Ustrd: ustrd}
+		}
+	}
+	tx.UndrlygCstmrCdtTrf = coverTransfer(fwm, tx)
+	return &Pacs009{
+		FICdtTrf: FinancialInstitutionCreditTransfer{
+			GrpHdr:      groupHeader(fwm),
+			CdtTrfTxInf: []FinancialInstitutionCreditTransferTransaction{tx},
+		},
+	}
+}
+
+// importPacs009 returns the FEDWireMessage of the first transaction of doc. A transaction with an underlying
+// customer credit transfer is a CustomerTransferPlus (CTP) cover payment, other transactions are a BankTransfer
+// (BTR) unless their category purpose is FEDFundsSold (FFS) or FEDFundsReturned (FFR).
+func importPacs009(doc *Pacs009) *wire.FEDWireMessage {
+	fwm := wire.NewFEDWireMessage()
+	hdr, tx := doc.FICdtTrf.GrpHdr, doc.FICdtTrf.CdtTrfTxInf[0]
+
+	bfc := wire.NewBusinessFunctionCode()
+	bfc.BusinessFunctionCode = wire.BankTransfer
+	typeCode := wire.FundsTransfer
+	code := ""
+	if tx.PmtTpInf != nil {
+		code = proprietaryValue(tx.PmtTpInf.CtgyPurp)
+	}
+	switch {
+	case tx.UndrlygCstmrCdtTrf != nil:
+		bfc.BusinessFunctionCode = wire.CustomerTransferPlus
+	case code == wire.FEDFundsSold, code == wire.FEDFundsReturned:
+		bfc.BusinessFunctionCode = code
+		typeCode = wire.SettlementTransfer
+	}
+	cover := bfc.BusinessFunctionCode == wire.CustomerTransferPlus
+
+	setGroupHeader(&fwm, hdr, typeCode, wire.BasicFundsTransfer)
+	setSettlement(&fwm, tx.IntrBkSttlmAmt, tx.InstgAgt, tx.InstdAgt)
+	fwm.SetBusinessFunctionCode(bfc)
+	setPaymentIdentification(&fwm, tx.PmtId)
+	setLocalInstrument(&fwm, tx.PmtTpInf)
+	if cover && fwm.LocalInstrument == nil {
+		li := wire.NewLocalInstrument()
+		li.LocalInstrumentCode = wire.SequenceBCoverPaymentStructured
+		fwm.SetLocalInstrument(li)
+	}
+
+	if tx.IntrmyAgt1 != nil {
+		fi := wire.NewBeneficiaryIntermediaryFI()
+		fi.FinancialInstitution = financialInstitution(tx.IntrmyAgt1)
+		fwm.SetBeneficiaryIntermediaryFI(fi)
+	}
+	if tx.CdtrAgt != nil {
+		fi := wire.NewBeneficiaryFI()
+		fi.FinancialInstitution = financialInstitution(tx.CdtrAgt)
+		fwm.SetBeneficiaryFI(fi)
+	}
+	// A CustomerTransferPlus requires an Originator and Beneficiary
+	if cover || !reflect.DeepEqual(tx.Cdtr, tx.InstdAgt) {
+		ben := wire.NewBeneficiary()
+		ben.Personal = wire.Personal(financialInstitution(&tx.Cdtr))
+		fwm.SetBeneficiary(ben)
+	}
+	if cover || !reflect.DeepEqual(tx.Dbtr, tx.InstgAgt) {
+		o := wire.NewOriginator()
+		o.Personal = wire.Personal(financialInstitution(&tx.Dbtr))
+		fwm.SetOriginator(o)
+	}
+	if tx.DbtrAgt != nil {
+		ofi := wire.NewOriginatorFI()
+		ofi.FinancialInstitution = financialInstitution(tx.DbtrAgt)
+		fwm.SetOriginatorFI(ofi)
+	}
+	if tx.PrvsInstgAgt1 != nil {
+		fi := wire.NewInstructingFI()
+		fi.FinancialInstitution = financialInstitution(tx.PrvsInstgAgt1)
+		fwm.SetInstructingFI(fi)
+	}
+	if tx.RmtInf != nil && len(tx.RmtInf.Ustrd) > 0 {
+		setRemittanceInformation(&fwm, &RemittanceInformation{Ustrd: tx.RmtInf.Ustrd})
+	}
+	setCoverTransfer(&fwm, tx)
+	return normalized(&fwm)
+}
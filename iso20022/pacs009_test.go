@@ -0,0 +1,165 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+// TestPacs009_RoundTrip exports bank transfers and cover payments, writes and reads the XML and imports it back
+func TestPacs009_RoundTrip(t *testing.T) {
+	fiToFI := []string{
+		wire.TagSenderSupplied, wire.TagPreviousMessageIdentifier, wire.TagFIIntermediaryFI,
+		wire.TagFIIntermediaryFIAdvice, wire.TagFIBeneficiaryFI, wire.TagFIBeneficiaryFIAdvice,
+		wire.TagFIBeneficiary, wire.TagFIBeneficiaryAdvice, wire.TagFIPaymentMethodToBeneficiary,
+		wire.TagFIAdditionalFIToFI,
+	}
+	for name, lost := range map[string][]string{
+		"fedWireMessage-BankTransfer.txt":     append([]string{wire.TagFIReceiverFI}, fiToFI...),
+		"fedWireMessage-FEDFundsSold.txt":     append([]string{wire.TagFIReceiverFI}, fiToFI...),
+		"fedWireMessage-FEDFundsReturned.txt": append([]string{wire.TagFIReceiverFI}, fiToFI...),
+		// The SWIFT field tags of the cover payment tags are not held by the document
+		"fedWireMessage-CustomerTransferPlusCOVS.txt": append([]string{
+			wire.TagPaymentNotification, wire.TagOriginatorOptionF, wire.TagCurrencyInstructedAmount,
+			wire.TagOrderingCustomer, wire.TagOrderingInstitution, wire.TagIntermediaryInstitution,
+			wire.TagInstitutionAccount, wire.TagBeneficiaryCustomer, wire.TagRemittance, wire.TagSenderToReceiver,
+		}, fiToFI...),
+	} {
+		fwm := readMessage(t, name)
+		doc, losses, err := ExportPacs009(fwm)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		tags := lossTags(losses)
+		for _, tag := range lost {
+			if !tags[tag] {
+				t.Errorf("%s: %s is not reported lost", name, tag)
+			}
+			delete(tags, tag)
+		}
+		if len(tags) != 0 {
+			t.Errorf("%s: unexpected losses %v in:\n%s", name, tags, losses)
+		}
+
+		var buf bytes.Buffer
+		if err := doc.Write(&buf); err != nil {
+			t.Fatal(err)
+		}
+		read, err := ReadPacs009(&buf)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		imported, importLosses, err := ImportPacs009(read)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		if len(importLosses) != 0 {
+			t.Errorf("%s: unexpected import losses:\n%s", name, importLosses)
+		}
+		if got := messageLosses(normalized(fwm), imported); len(got) != len(losses) {
+			t.Errorf("%s: imported message differs:\n%s", name, got)
+		}
+		checkImported(t, name, imported)
+		if got, want := imported.BusinessFunctionCode.BusinessFunctionCode, fwm.BusinessFunctionCode.BusinessFunctionCode; got != want {
+			t.Errorf("%s: BusinessFunctionCode %s, want %s", name, got, want)
+		}
+	}
+}
+
+// TestExportPacs009_BusinessFunctionCode validates only bank transfers and cover payments are exported
+func TestExportPacs009_BusinessFunctionCode(t *testing.T) {
+	for _, name := range []string{"fedWireMessage-CustomerTransfer.txt", "fedWireMessage-CustomerTransferPlus.txt"} {
+		fwm := readMessage(t, name)
+		if _, _, err := ExportPacs009(fwm); !base.Match(err, ErrUnsupportedBusinessFunctionCode) {
+			t.Errorf("%s: %T: %s", name, err, err)
+		}
+	}
+}
+
+// TestImportPacs009_Cover validates the underlying customer credit transfer of a pacs.009 COV
+func TestImportPacs009_Cover(t *testing.T) {
+	doc, err := ReadPacs009(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08">
+  <FICdtTrf>
+    <GrpHdr>
+      <MsgId>20190410Source08000001</MsgId>
+      <CreDtTm>2019-04-10T10:00:00Z</CreDtTm>
+      <NbOfTxs>1</NbOfTxs>
+      <SttlmInf><SttlmMtd>CLRG</SttlmMtd><ClrSys><Cd>FDW</Cd></ClrSys></SttlmInf>
+    </GrpHdr>
+    <CdtTrfTxInf>
+      <PmtId><InstrId>Sender Reference</InstrId><EndToEndId>Reference</EndToEndId></PmtId>
+      <IntrBkSttlmAmt Ccy="USD">12345.67</IntrBkSttlmAmt>
+      <InstgAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>121042882</MmbId></ClrSysMmbId></FinInstnId></InstgAgt>
+      <InstdAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>231380104</MmbId></ClrSysMmbId></FinInstnId></InstdAgt>
+      <Dbtr><FinInstnId><BICFI>WFBIUS6SXXX</BICFI></FinInstnId></Dbtr>
+      <Cdtr><FinInstnId><BICFI>CITIUS33XXX</BICFI></FinInstnId></Cdtr>
+      <UndrlygCstmrCdtTrf>
+        <Dbtr><Nm>Debtor</Nm><PstlAdr><AdrLine>Address One</AdrLine></PstlAdr></Dbtr>
+        <DbtrAcct><Id><Othr><Id>123456789</Id></Othr></Id></DbtrAcct>
+        <DbtrAgt><FinInstnId><BICFI>DEUTDEFFXXX</BICFI></FinInstnId></DbtrAgt>
+        <IntrmyAgt1><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>021000021</MmbId></ClrSysMmbId><Nm>Intermediary</Nm></FinInstnId></IntrmyAgt1>
+        <CdtrAgt><FinInstnId><BICFI>CITIUS33XXX</BICFI></FinInstnId></CdtrAgt>
+        <Cdtr><Id><OrgId><AnyBIC>MOOVUS33XXX</AnyBIC></OrgId></Id></Cdtr>
+        <CdtrAcct><Id><Othr><Id>987654321</Id></Othr></Id></CdtrAcct>
+        <UltmtCdtr><Nm>Ultimate</Nm></UltmtCdtr>
+        <RmtInf><Ustrd>Invoice 1234</Ustrd></RmtInf>
+        <InstdAmt Ccy="EUR">1500.49</InstdAmt>
+      </UndrlygCstmrCdtTrf>
+    </CdtTrfTxInf>
+  </FICdtTrf>
+</Document>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fwm, losses, err := ImportPacs009(doc)
+	if err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if fwm.BusinessFunctionCode.BusinessFunctionCode != wire.CustomerTransferPlus || !isCoverPayment(fwm) {
+		t.Errorf("unexpected BusinessFunctionCode %v and LocalInstrument %v", fwm.BusinessFunctionCode, fwm.LocalInstrument)
+	}
+	if cp := fwm.OrderingCustomer.CoverPayment; cp.SwiftFieldTag != "50K" || cp.SwiftLineOne != "/123456789" || cp.SwiftLineTwo != "Debtor" {
+		t.Errorf("unexpected OrderingCustomer %#v", cp)
+	}
+	if cp := fwm.OrderingInstitution.CoverPayment; cp.SwiftFieldTag != "52A" || cp.SwiftLineOne != "DEUTDEFFXXX" {
+		t.Errorf("unexpected OrderingInstitution %#v", cp)
+	}
+	if cp := fwm.IntermediaryInstitution.CoverPayment; cp.SwiftFieldTag != "56D" || cp.SwiftLineOne != "//FW021000021" || cp.SwiftLineTwo != "Intermediary" {
+		t.Errorf("unexpected IntermediaryInstitution %#v", cp)
+	}
+	if fwm.InstitutionAccount != nil {
+		t.Errorf("InstitutionAccount of the creditor is set %#v", fwm.InstitutionAccount)
+	}
+	// the debtor is imported once, as the Originator {5000}
+	if fwm.Originator == nil || fwm.OriginatorOptionF != nil {
+		t.Errorf("unexpected Originator %v and OriginatorOptionF %v", fwm.Originator, fwm.OriginatorOptionF)
+	}
+	if cp := fwm.BeneficiaryCustomer.CoverPayment; cp.SwiftFieldTag != "59A" || cp.SwiftLineOne != "/987654321" || cp.SwiftLineTwo != "MOOVUS33XXX" {
+		t.Errorf("unexpected BeneficiaryCustomer %#v", cp)
+	}
+	if fwm.Remittance.CoverPayment.SwiftLineOne != "Invoice 1234" || fwm.CurrencyInstructedAmount.AmountField() != "000000000001500,49" {
+		t.Errorf("unexpected Remittance %#v or CurrencyInstructedAmount %#v", fwm.Remittance, fwm.CurrencyInstructedAmount)
+	}
+
+	for _, element := range []string{"UndrlygCstmrCdtTrf/UltmtCdtr", "UndrlygCstmrCdtTrf/InstdAmt/Ccy"} {
+		found := false
+		for i := range losses {
+			found = found || strings.HasSuffix(losses[i].Element, element)
+		}
+		if !found {
+			t.Errorf("%s is not reported lost in\n%s", element, losses)
+		}
+	}
+
+	if _, _, err := ImportPacs009(&Pacs009{}); err != ErrNoTransaction {
+		t.Errorf("%T: %s", err, err)
+	}
+}
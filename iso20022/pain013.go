@@ -0,0 +1,170 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/moov-io/wire"
+)
+
+// Pain013Namespace is the XML namespace of a pain.013 CreditorPaymentActivationRequest
+const Pain013Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.013.001.07"
+
+// pain013MessageName is the message name identification of a pain.013, which a pain.014 refers to
+const pain013MessageName = "pain.013.001.07"
+
+// Pain013 is a pain.013 CreditorPaymentActivationRequest document
+type Pain013 struct {
+	XMLName          xml.Name                         `xml:"urn:iso:std:iso:20022:tech:xsd:pain.013.001.07 Document"`
+	CdtrPmtActvtnReq CreditorPaymentActivationRequest `xml:"CdtrPmtActvtnReq"`
+}
+
+// CreditorPaymentActivationRequest is a request from a creditor to a debtor to transfer funds, a drawdown
+type CreditorPaymentActivationRequest struct {
+	GrpHdr PaymentActivationGroupHeader   `xml:"GrpHdr"`
+	PmtInf []PaymentActivationInstruction `xml:"PmtInf"`
+}
+
+// PaymentActivationGroupHeader identifies a creditor payment activation request
+type PaymentActivationGroupHeader struct {
+	MsgId    string              `xml:"MsgId"`
+	CreDtTm  string              `xml:"CreDtTm"`
+	NbOfTxs  string              `xml:"NbOfTxs"`
+	InitgPty PartyIdentification `xml:"InitgPty"`
+}
+
+// PaymentActivationInstruction is the debtor side of a creditor payment activation request
+type PaymentActivationInstruction struct {
+	PmtInfId    string                                      `xml:"PmtInfId"`
+	PmtMtd      string                                      `xml:"PmtMtd"`
+	PmtTpInf    *PaymentTypeInformation                     `xml:"PmtTpInf,omitempty"`
+	ReqdExctnDt DateAndDateTime                             `xml:"ReqdExctnDt"`
+	Dbtr        PartyIdentification                         `xml:"Dbtr"`
+	DbtrAcct    *CashAccount                                `xml:"DbtrAcct,omitempty"`
+	DbtrAgt     BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt"`
+	UltmtDbtr   *PartyIdentification                        `xml:"UltmtDbtr,omitempty"`
+	CdtTrfTx    []PaymentActivationTransaction              `xml:"CdtTrfTx"`
+	// Unsupported holds the elements which are not converted, so they can be reported
+	Unsupported []Element `xml:",any"`
+}
+
+// PaymentActivationTransaction is the creditor side of a creditor payment activation request
+type PaymentActivationTransaction struct {
+	PmtId    PaymentIdentification                       `xml:"PmtId"`
+	Amt      AmountType                                  `xml:"Amt"`
+	CdtrAgt  BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt"`
+	Cdtr     PartyIdentification                         `xml:"Cdtr"`
+	CdtrAcct *CashAccount                                `xml:"CdtrAcct,omitempty"`
+	RmtInf   *RemittanceInformation                      `xml:"RmtInf,omitempty"`
+	// Unsupported holds the elements which are not converted, so they can be reported
+	Unsupported []Element `xml:",any"`
+}
+
+// ReadPain013 reads a pain.013 document
+func ReadPain013(r io.Reader) (*Pain013, error) {
+	doc := &Pain013{}
+	if err := xml.NewDecoder(r).Decode(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Write writes the document as XML
+func (doc *Pain013) Write(w io.Writer) error {
+	return writeDocument(w, doc)
+}
+
+// ExportPain013 converts a DrawDownRequest (DRW), BankDrawDownRequest (DRB) or CustomerCorporateDrawdownRequest
+// (DRC) FEDWireMessage to a pain.013 document. The sender {3100} is the creditor agent and the receiver {3400}
+// is the debtor agent, the financial institutions of the Beneficiary are not held. The returned Losses describe
+// every value of fwm which the document does not hold.
+func ExportPain013(fwm *wire.FEDWireMessage) (*Pain013, Losses, error) {
+	if err := drawdownMandatory(fwm, false); err != nil {
+		return nil, nil, err
+	}
+	doc := pain013(fwm)
+	doc.CdtrPmtActvtnReq.GrpHdr.CreDtTm = creationDateTime()
+	return doc, messageLosses(normalized(fwm), importPain013(doc)), nil
+}
+
+// ImportPain013 converts a pain.013 document to a FEDWireMessage. The returned Losses describe every element of
+// doc which the FEDWireMessage does not hold.
+func ImportPain013(doc *Pain013) (*wire.FEDWireMessage, Losses, error) {
+	if len(doc.CdtrPmtActvtnReq.PmtInf) == 0 || len(doc.CdtrPmtActvtnReq.PmtInf[0].CdtTrfTx) == 0 {
+		return nil, nil, ErrNoTransaction
+	}
+	fwm := importPain013(doc)
+	again := pain013(fwm)
+	again.CdtrPmtActvtnReq.GrpHdr.CreDtTm = doc.CdtrPmtActvtnReq.GrpHdr.CreDtTm
+	return fwm, documentLosses(doc, again), nil
+}
+
+// pain013 returns the pain.013 document of fwm, without a creation date time. The creditor initiates the
+// request.
+func pain013(fwm *wire.FEDWireMessage) *Pain013 {
+	p := parties(fwm)
+	pmtID := paymentIdentification(fwm)
+	pmtInf := PaymentActivationInstruction{
+		PmtInfId:    pmtID.InstrId,
+		PmtMtd:      paymentMethodTransfer,
+		PmtTpInf:    paymentTypeInformation(fwm),
+		ReqdExctnDt: DateAndDateTime{Dt: isoDate(fwm.InputMessageAccountabilityData.InputCycleDate)},
+		Dbtr:        p.Dbtr,
+		DbtrAcct:    p.DbtrAcct,
+		DbtrAgt:     abaAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName),
+		UltmtDbtr:   p.UltmtDbtr,
+	}
+	if pmtInf.PmtInfId == "" {
+		pmtInf.PmtInfId = notProvided
+	}
+	tx := PaymentActivationTransaction{
+		PmtId:    pmtID,
+		Amt:      AmountType{InstdAmt: ActiveCurrencyAndAmount{Ccy: "USD", Value: decimalAmount(fwm.Amount.Amount)}},
+		CdtrAgt:  abaAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName),
+		Cdtr:     p.Cdtr,
+		CdtrAcct: p.CdtrAcct,
+		RmtInf:   remittanceInformation(fwm),
+	}
+	pmtInf.CdtTrfTx = []PaymentActivationTransaction{tx}
+	hdr := groupHeader(fwm)
+	return &Pain013{
+		CdtrPmtActvtnReq: CreditorPaymentActivationRequest{
+			GrpHdr: PaymentActivationGroupHeader{
+				MsgId:    hdr.MsgId,
+				NbOfTxs:  hdr.NbOfTxs,
+				InitgPty: p.Cdtr,
+			},
+			PmtInf: []PaymentActivationInstruction{pmtInf},
+		},
+	}
+}
+
+// importPain013 returns the FEDWireMessage of the first transaction of doc
+func importPain013(doc *Pain013) *wire.FEDWireMessage {
+	fwm := wire.NewFEDWireMessage()
+	pmtInf := doc.CdtrPmtActvtnReq.PmtInf[0]
+	tx := pmtInf.CdtTrfTx[0]
+
+	bfc := wire.NewBusinessFunctionCode()
+	bfc.BusinessFunctionCode = drawdownCode(pmtInf.PmtTpInf)
+	typeCode, subTypeCode := drawdownTypeSubType(bfc.BusinessFunctionCode, false)
+	setGroupHeader(&fwm, GroupHeader{MsgId: doc.CdtrPmtActvtnReq.GrpHdr.MsgId}, typeCode, subTypeCode)
+	setSettlement(&fwm, tx.Amt.InstdAmt, tx.CdtrAgt, pmtInf.DbtrAgt)
+	fwm.SetBusinessFunctionCode(bfc)
+	setPaymentIdentification(&fwm, tx.PmtId)
+	setLocalInstrument(&fwm, pmtInf.PmtTpInf)
+
+	setParties(&fwm, drawdownParties{
+		Dbtr:      pmtInf.Dbtr,
+		DbtrAcct:  pmtInf.DbtrAcct,
+		UltmtDbtr: pmtInf.UltmtDbtr,
+		Cdtr:      tx.Cdtr,
+		CdtrAcct:  tx.CdtrAcct,
+	})
+	setRemittanceInformation(&fwm, tx.RmtInf)
+	return normalized(&fwm)
+}
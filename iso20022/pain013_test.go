@@ -0,0 +1,91 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+// TestPain013_RoundTrip exports drawdown requests, writes and reads the XML and imports it back
+func TestPain013_RoundTrip(t *testing.T) {
+	lost := []string{
+		wire.TagSenderSupplied, wire.TagPreviousMessageIdentifier, wire.TagBeneficiaryIntermediaryFI, wire.TagBeneficiaryFI,
+		wire.TagOriginatorFI, wire.TagInstructingFI, wire.TagFIReceiverFI, wire.TagFIIntermediaryFI,
+		wire.TagFIIntermediaryFIAdvice, wire.TagFIBeneficiaryFI, wire.TagFIBeneficiaryFIAdvice,
+		wire.TagFIBeneficiary, wire.TagFIBeneficiaryAdvice, wire.TagFIPaymentMethodToBeneficiary,
+		wire.TagFIAdditionalFIToFI,
+	}
+	for name, lost := range map[string][]string{
+		"fedWireMessage-DrawDownRequest.txt":                  lost,
+		"fedWireMessage-BankDrawDownRequest.txt":              lost,
+		"fedWireMessage-CustomerCorporateDrawDownRequest.txt": append([]string{wire.TagFIDrawdownDebitAccountAdvice}, lost...),
+	} {
+		fwm := readMessage(t, name)
+		doc, losses, err := ExportPain013(fwm)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		tags := lossTags(losses)
+		for _, tag := range lost {
+			if !tags[tag] {
+				t.Errorf("%s: %s is not reported lost", name, tag)
+			}
+			delete(tags, tag)
+		}
+		if len(tags) != 0 {
+			t.Errorf("%s: unexpected losses %v in:\n%s", name, tags, losses)
+		}
+
+		var buf bytes.Buffer
+		if err := doc.Write(&buf); err != nil {
+			t.Fatal(err)
+		}
+		read, err := ReadPain013(&buf)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		imported, importLosses, err := ImportPain013(read)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		if len(importLosses) != 0 {
+			t.Errorf("%s: unexpected import losses:\n%s", name, importLosses)
+		}
+		if got := messageLosses(normalized(fwm), imported); len(got) != len(losses) {
+			t.Errorf("%s: imported message differs:\n%s", name, got)
+		}
+		if errs := imported.ValidateAll(); len(errs) != 0 {
+			t.Errorf("%s: imported message is invalid:\n%s", name, errs)
+		}
+		if got, want := imported.BusinessFunctionCode.BusinessFunctionCode, fwm.BusinessFunctionCode.BusinessFunctionCode; got != want {
+			t.Errorf("%s: BusinessFunctionCode %s, want %s", name, got, want)
+		}
+		if got, want := imported.TypeSubType.String(), fwm.TypeSubType.String(); got != want {
+			t.Errorf("%s: TypeSubType %s, want %s", name, got, want)
+		}
+	}
+}
+
+// TestExportPain013_BusinessFunctionCode validates only drawdown requests are exported
+func TestExportPain013_BusinessFunctionCode(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	if _, _, err := ExportPain013(fwm); !base.Match(err, ErrUnsupportedBusinessFunctionCode) {
+		t.Errorf("%T: %s", err, err)
+	}
+
+	fwm = readMessage(t, "fedWireMessage-BankDrawDownRequest.txt")
+	fwm.TypeSubType.SubTypeCode = wire.RefusalRequestCredit
+	if _, _, err := ExportPain013(fwm); !base.Match(err, ErrUnsupportedBusinessFunctionCode) {
+		t.Errorf("refusal: %T: %s", err, err)
+	}
+
+	if _, _, err := ImportPain013(&Pain013{}); err != ErrNoTransaction {
+		t.Errorf("%T: %s", err, err)
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// Pain014Namespace is the XML namespace of a pain.014 CreditorPaymentActivationRequestStatusReport
+const Pain014Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.014.001.07"
+
+// statusRejected is the status of a refused drawdown request
+const statusRejected = "RJCT"
+
+// Pain014 is a pain.014 CreditorPaymentActivationRequestStatusReport document
+type Pain014 struct {
+	XMLName                xml.Name                                     `xml:"urn:iso:std:iso:20022:tech:xsd:pain.014.001.07 Document"`
+	CdtrPmtActvtnReqStsRpt CreditorPaymentActivationRequestStatusReport `xml:"CdtrPmtActvtnReqStsRpt"`
+}
+
+// CreditorPaymentActivationRequestStatusReport is the status of a creditor payment activation request, e.g.
+// its refusal
+type CreditorPaymentActivationRequestStatusReport struct {
+	GrpHdr            StatusReportGroupHeader               `xml:"GrpHdr"`
+	OrgnlGrpInfAndSts OriginalGroupInformationAndStatus     `xml:"OrgnlGrpInfAndSts"`
+	OrgnlPmtInfAndSts []OriginalPaymentInformationAndStatus `xml:"OrgnlPmtInfAndSts,omitempty"`
+}
+
+// StatusReportGroupHeader identifies a status report
+type StatusReportGroupHeader struct {
+	MsgId    string                                       `xml:"MsgId"`
+	CreDtTm  string                                       `xml:"CreDtTm"`
+	InitgPty PartyIdentification                          `xml:"InitgPty"`
+	DbtrAgt  *BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt,omitempty"`
+	CdtrAgt  *BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt,omitempty"`
+}
+
+// OriginalGroupInformationAndStatus identifies the original request and its status
+type OriginalGroupInformationAndStatus struct {
+	OrgnlMsgId   string `xml:"OrgnlMsgId"`
+	OrgnlMsgNmId string `xml:"OrgnlMsgNmId"`
+	GrpSts       string `xml:"GrpSts,omitempty"`
+}
+
+// OriginalPaymentInformationAndStatus is the status of the payment information of the original request
+type OriginalPaymentInformationAndStatus struct {
+	OrgnlPmtInfId string                               `xml:"OrgnlPmtInfId"`
+	TxInfAndSts   []PaymentActivationTransactionStatus `xml:"TxInfAndSts,omitempty"`
+}
+
+// PaymentActivationTransactionStatus is the status of a transaction of the original request
+type PaymentActivationTransactionStatus struct {
+	OrgnlInstrId    string                        `xml:"OrgnlInstrId,omitempty"`
+	OrgnlEndToEndId string                        `xml:"OrgnlEndToEndId,omitempty"`
+	TxSts           string                        `xml:"TxSts,omitempty"`
+	StsRsnInf       []StatusReasonInformation     `xml:"StsRsnInf,omitempty"`
+	OrgnlTxRef      *OriginalTransactionReference `xml:"OrgnlTxRef,omitempty"`
+	// Unsupported holds the elements which are not converted, so they can be reported
+	Unsupported []Element `xml:",any"`
+}
+
+// StatusReasonInformation is the reason of a status
+type StatusReasonInformation struct {
+	Rsn      *CodeOrProprietary `xml:"Rsn,omitempty"`
+	AddtlInf []string           `xml:"AddtlInf,omitempty"`
+}
+
+// PartyOrAgent is either a party or an agent
+type PartyOrAgent struct {
+	Pty *PartyIdentification                         `xml:"Pty,omitempty"`
+	Agt *BranchAndFinancialInstitutionIdentification `xml:"Agt,omitempty"`
+}
+
+// OriginalTransactionReference holds the values of the original transaction
+type OriginalTransactionReference struct {
+	Amt         *AmountType             `xml:"Amt,omitempty"`
+	ReqdExctnDt *DateAndDateTime        `xml:"ReqdExctnDt,omitempty"`
+	PmtTpInf    *PaymentTypeInformation `xml:"PmtTpInf,omitempty"`
+	RmtInf      *RemittanceInformation  `xml:"RmtInf,omitempty"`
+	UltmtDbtr   *PartyOrAgent           `xml:"UltmtDbtr,omitempty"`
+	Dbtr        *PartyOrAgent           `xml:"Dbtr,omitempty"`
+	DbtrAcct    *CashAccount            `xml:"DbtrAcct,omitempty"`
+	Cdtr        *PartyOrAgent           `xml:"Cdtr,omitempty"`
+	CdtrAcct    *CashAccount            `xml:"CdtrAcct,omitempty"`
+}
+
+// ReadPain014 reads a pain.014 document
+func ReadPain014(r io.Reader) (*Pain014, error) {
+	doc := &Pain014{}
+	if err := xml.NewDecoder(r).Decode(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Write writes the document as XML
+func (doc *Pain014) Write(w io.Writer) error {
+	return writeDocument(w, doc)
+}
+
+// ExportPain014 converts the refusal (RefusalRequestCredit) of a BankDrawDownRequest (DRB) or
+// CustomerCorporateDrawdownRequest (DRC) FEDWireMessage to a pain.014 document which rejects the original
+// request, the IMAD of PreviousMessageIdentifier {3500}. The sender {3100} is the debtor agent, the receiver
+// {3400} is the creditor agent and OriginatorToBeneficiary {6000} is the reason. The returned Losses describe
+// every value of fwm which the document does not hold.
+func ExportPain014(fwm *wire.FEDWireMessage) (*Pain014, Losses, error) {
+	if err := drawdownMandatory(fwm, true); err != nil {
+		return nil, nil, err
+	}
+	doc := pain014(fwm)
+	doc.CdtrPmtActvtnReqStsRpt.GrpHdr.CreDtTm = creationDateTime()
+	return doc, messageLosses(normalized(fwm), importPain014(doc)), nil
+}
+
+// ImportPain014 converts a pain.014 document to a refusal FEDWireMessage. The returned Losses describe every
+// element of doc which the FEDWireMessage does not hold.
+func ImportPain014(doc *Pain014) (*wire.FEDWireMessage, Losses, error) {
+	rpt := doc.CdtrPmtActvtnReqStsRpt
+	if len(rpt.OrgnlPmtInfAndSts) == 0 || len(rpt.OrgnlPmtInfAndSts[0].TxInfAndSts) == 0 {
+		return nil, nil, ErrNoTransaction
+	}
+	fwm := importPain014(doc)
+	again := pain014(fwm)
+	again.CdtrPmtActvtnReqStsRpt.GrpHdr.CreDtTm = rpt.GrpHdr.CreDtTm
+	return fwm, documentLosses(doc, again), nil
+}
+
+// pain014 returns the pain.014 document of fwm, without a creation date time. The debtor initiates the
+// refusal.
+func pain014(fwm *wire.FEDWireMessage) *Pain014 {
+	p := parties(fwm)
+	pmtID := paymentIdentification(fwm)
+	orgnlMsgID := notProvided
+	if pmi := fwm.PreviousMessageIdentifier; pmi != nil && strings.TrimSpace(pmi.PreviousMessageIdentifier) != "" {
+		orgnlMsgID = strings.TrimSpace(pmi.PreviousMessageIdentifier)
+	}
+	sts := PaymentActivationTransactionStatus{
+		OrgnlInstrId:    pmtID.InstrId,
+		OrgnlEndToEndId: pmtID.EndToEndId,
+		TxSts:           statusRejected,
+		OrgnlTxRef: &OriginalTransactionReference{
+			Amt:         &AmountType{InstdAmt: ActiveCurrencyAndAmount{Ccy: "USD", Value: decimalAmount(fwm.Amount.Amount)}},
+			ReqdExctnDt: &DateAndDateTime{Dt: isoDate(fwm.InputMessageAccountabilityData.InputCycleDate)},
+			PmtTpInf:    paymentTypeInformation(fwm),
+			Dbtr:        &PartyOrAgent{Pty: &p.Dbtr},
+			DbtrAcct:    p.DbtrAcct,
+			Cdtr:        &PartyOrAgent{Pty: &p.Cdtr},
+			CdtrAcct:    p.CdtrAcct,
+		},
+	}
+	if p.UltmtDbtr != nil {
+		sts.OrgnlTxRef.UltmtDbtr = &PartyOrAgent{Pty: p.UltmtDbtr}
+	}
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		var reasons []string
+		for _, line := range []string{ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour} {
+			if line = strings.TrimSpace(line); line != "" {
+				reasons = append(reasons, line)
+			}
+		}
+		if len(reasons) > 0 {
+			sts.StsRsnInf = []StatusReasonInformation{{AddtlInf: reasons}}
+		}
+	}
+	if ri := remittanceInformation(fwm); ri != nil && len(ri.Strd) > 0 {
+		sts.OrgnlTxRef.RmtInf = &RemittanceInformation{Strd: ri.Strd}
+	}
+	pmtInfID := pmtID.InstrId
+	if pmtInfID == "" {
+		pmtInfID = notProvided
+	}
+	dbtrAgt := abaAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName)
+	cdtrAgt := abaAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	return &Pain014{
+		CdtrPmtActvtnReqStsRpt: CreditorPaymentActivationRequestStatusReport{
+			GrpHdr: StatusReportGroupHeader{
+				MsgId:    groupHeader(fwm).MsgId,
+				InitgPty: p.Dbtr,
+				DbtrAgt:  &dbtrAgt,
+				CdtrAgt:  &cdtrAgt,
+			},
+			OrgnlGrpInfAndSts: OriginalGroupInformationAndStatus{
+				OrgnlMsgId:   orgnlMsgID,
+				OrgnlMsgNmId: pain013MessageName,
+				GrpSts:       statusRejected,
+			},
+			OrgnlPmtInfAndSts: []OriginalPaymentInformationAndStatus{{
+				OrgnlPmtInfId: pmtInfID,
+				TxInfAndSts:   []PaymentActivationTransactionStatus{sts},
+			}},
+		},
+	}
+}
+
+// importPain014 returns the refusal FEDWireMessage of the first transaction of doc
+func importPain014(doc *Pain014) *wire.FEDWireMessage {
+	fwm := wire.NewFEDWireMessage()
+	rpt := doc.CdtrPmtActvtnReqStsRpt
+	pmtInf := rpt.OrgnlPmtInfAndSts[0]
+	sts := pmtInf.TxInfAndSts[0]
+	ref := sts.OrgnlTxRef
+	if ref == nil {
+		ref = &OriginalTransactionReference{}
+	}
+
+	bfc := wire.NewBusinessFunctionCode()
+	bfc.BusinessFunctionCode = drawdownCode(ref.PmtTpInf)
+	if bfc.BusinessFunctionCode == wire.DrawDownRequest {
+		// A DrawDownRequest (DRW) can not be refused
+		bfc.BusinessFunctionCode = wire.CustomerCorporateDrawdownRequest
+	}
+	typeCode, subTypeCode := drawdownTypeSubType(bfc.BusinessFunctionCode, true)
+	setGroupHeader(&fwm, GroupHeader{MsgId: rpt.GrpHdr.MsgId}, typeCode, subTypeCode)
+	var amount ActiveCurrencyAndAmount
+	if ref.Amt != nil {
+		amount = ref.Amt.InstdAmt
+	}
+	var dbtrAgt, cdtrAgt BranchAndFinancialInstitutionIdentification
+	if rpt.GrpHdr.DbtrAgt != nil {
+		dbtrAgt = *rpt.GrpHdr.DbtrAgt
+	}
+	if rpt.GrpHdr.CdtrAgt != nil {
+		cdtrAgt = *rpt.GrpHdr.CdtrAgt
+	}
+	setSettlement(&fwm, amount, dbtrAgt, cdtrAgt)
+	fwm.SetBusinessFunctionCode(bfc)
+	setPaymentIdentification(&fwm, PaymentIdentification{InstrId: sts.OrgnlInstrId, EndToEndId: sts.OrgnlEndToEndId})
+	setLocalInstrument(&fwm, ref.PmtTpInf)
+	if id := rpt.OrgnlGrpInfAndSts.OrgnlMsgId; id != "" && id != notProvided {
+		pmi := wire.NewPreviousMessageIdentifier()
+		pmi.PreviousMessageIdentifier = id
+		fwm.SetPreviousMessageIdentifier(pmi)
+	}
+
+	p := drawdownParties{DbtrAcct: ref.DbtrAcct, CdtrAcct: ref.CdtrAcct}
+	if ref.Dbtr != nil && ref.Dbtr.Pty != nil {
+		p.Dbtr = *ref.Dbtr.Pty
+	}
+	if ref.UltmtDbtr != nil {
+		p.UltmtDbtr = ref.UltmtDbtr.Pty
+	}
+	if ref.Cdtr != nil && ref.Cdtr.Pty != nil {
+		p.Cdtr = *ref.Cdtr.Pty
+	}
+	setParties(&fwm, p)
+
+	var reasons []string
+	for i := range sts.StsRsnInf {
+		reasons = append(reasons, sts.StsRsnInf[i].AddtlInf...)
+	}
+	if len(reasons) > 0 {
+		reasons = append(reasons, make([]string, 4)...)
+		ob := wire.NewOriginatorToBeneficiary()
+		ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = reasons[0], reasons[1], reasons[2], reasons[3]
+		fwm.SetOriginatorToBeneficiary(ob)
+	}
+	if ref.RmtInf != nil && len(ref.RmtInf.Strd) > 0 {
+		setStructuredRemittance(&fwm, &ref.RmtInf.Strd[0])
+	}
+	return normalized(&fwm)
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+)
+
+// TestPain014_RoundTrip exports refusals of drawdown requests, writes and reads the XML and imports it back
+func TestPain014_RoundTrip(t *testing.T) {
+	lost := []string{
+		wire.TagSenderSupplied, wire.TagBeneficiaryIntermediaryFI, wire.TagBeneficiaryFI, wire.TagOriginatorFI,
+		wire.TagInstructingFI, wire.TagFIReceiverFI, wire.TagFIIntermediaryFI, wire.TagFIIntermediaryFIAdvice,
+		wire.TagFIBeneficiaryFI, wire.TagFIBeneficiaryFIAdvice, wire.TagFIBeneficiary, wire.TagFIBeneficiaryAdvice,
+		wire.TagFIPaymentMethodToBeneficiary, wire.TagFIAdditionalFIToFI,
+	}
+	for name, lost := range map[string][]string{
+		"fedWireMessage-BankDrawDownRequest.txt":              lost,
+		"fedWireMessage-CustomerCorporateDrawDownRequest.txt": append([]string{wire.TagFIDrawdownDebitAccountAdvice}, lost...),
+	} {
+		fwm := readMessage(t, name)
+		fwm.TypeSubType.SubTypeCode = wire.RefusalRequestCredit
+		doc, losses, err := ExportPain014(fwm)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		tags := lossTags(losses)
+		for _, tag := range lost {
+			if !tags[tag] {
+				t.Errorf("%s: %s is not reported lost", name, tag)
+			}
+			delete(tags, tag)
+		}
+		if len(tags) != 0 {
+			t.Errorf("%s: unexpected losses %v in:\n%s", name, tags, losses)
+		}
+		rpt := doc.CdtrPmtActvtnReqStsRpt
+		if got, want := rpt.OrgnlGrpInfAndSts.OrgnlMsgId, fwm.PreviousMessageIdentifier.PreviousMessageIdentifier; got != strings.TrimSpace(want) {
+			t.Errorf("%s: OrgnlMsgId %s, want %s", name, got, want)
+		}
+		if rsn := rpt.OrgnlPmtInfAndSts[0].TxInfAndSts[0].StsRsnInf; len(rsn) != 1 || rsn[0].AddtlInf[0] != strings.TrimSpace(fwm.OriginatorToBeneficiary.LineOne) {
+			t.Errorf("%s: unexpected StsRsnInf %#v", name, rsn)
+		}
+
+		var buf bytes.Buffer
+		if err := doc.Write(&buf); err != nil {
+			t.Fatal(err)
+		}
+		read, err := ReadPain014(&buf)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		imported, importLosses, err := ImportPain014(read)
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		if len(importLosses) != 0 {
+			t.Errorf("%s: unexpected import losses:\n%s", name, importLosses)
+		}
+		if got := messageLosses(normalized(fwm), imported); len(got) != len(losses) {
+			t.Errorf("%s: imported message differs:\n%s", name, got)
+		}
+		if errs := imported.ValidateAll(); len(errs) != 0 {
+			t.Errorf("%s: imported message is invalid:\n%s", name, errs)
+		}
+		if got, want := imported.TypeSubType.String(), fwm.TypeSubType.String(); got != want {
+			t.Errorf("%s: TypeSubType %s, want %s", name, got, want)
+		}
+	}
+}
+
+// TestExportPain014_Refusal validates only refusals of drawdown requests are exported
+func TestExportPain014_Refusal(t *testing.T) {
+	for _, name := range []string{"fedWireMessage-BankDrawDownRequest.txt", "fedWireMessage-CustomerTransfer.txt"} {
+		fwm := readMessage(t, name)
+		if _, _, err := ExportPain014(fwm); !base.Match(err, ErrUnsupportedBusinessFunctionCode) {
+			t.Errorf("%s: %T: %s", name, err, err)
+		}
+	}
+
+	if _, _, err := ImportPain014(&Pain014{}); err != ErrNoTransaction {
+		t.Errorf("%T: %s", err, err)
+	}
+}
@@ -0,0 +1,498 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// NameAndAddress is a name and postal address
+type NameAndAddress struct {
+	Nm  string        `xml:"Nm"`
+	Adr PostalAddress `xml:"Adr"`
+}
+
+// RemittanceLocationData describes where remittance information is sent
+type RemittanceLocationData struct {
+	Mtd        string          `xml:"Mtd"`
+	ElctrncAdr string          `xml:"ElctrncAdr,omitempty"`
+	PstlAdr    *NameAndAddress `xml:"PstlAdr,omitempty"`
+}
+
+// RemittanceLocation identifies remittance information sent separately from a payment
+type RemittanceLocation struct {
+	RmtId       string                   `xml:"RmtId,omitempty"`
+	RmtLctnDtls []RemittanceLocationData `xml:"RmtLctnDtls,omitempty"`
+}
+
+// DocumentType is the type of a referred document
+type DocumentType struct {
+	CdOrPrtry CodeOrProprietary `xml:"CdOrPrtry"`
+	Issr      string            `xml:"Issr,omitempty"`
+}
+
+// ReferredDocumentInformation identifies a document a payment settles, e.g. an invoice
+type ReferredDocumentInformation struct {
+	Tp     *DocumentType `xml:"Tp,omitempty"`
+	Nb     string        `xml:"Nb,omitempty"`
+	RltdDt string        `xml:"RltdDt,omitempty"`
+}
+
+// DiscountAmountAndType is a discount applied to a referred document
+type DiscountAmountAndType struct {
+	Amt ActiveCurrencyAndAmount `xml:"Amt"`
+}
+
+// DocumentAdjustment is an adjustment to the amount of a referred document
+type DocumentAdjustment struct {
+	Amt       ActiveCurrencyAndAmount `xml:"Amt"`
+	CdtDbtInd string                  `xml:"CdtDbtInd,omitempty"`
+	Rsn       string                  `xml:"Rsn,omitempty"`
+	AddtlInf  string                  `xml:"AddtlInf,omitempty"`
+}
+
+// RemittanceAmount holds the amounts of a referred document
+type RemittanceAmount struct {
+	DuePyblAmt        *ActiveCurrencyAndAmount `xml:"DuePyblAmt,omitempty"`
+	DscntApldAmt      []DiscountAmountAndType  `xml:"DscntApldAmt,omitempty"`
+	AdjstmntAmtAndRsn []DocumentAdjustment     `xml:"AdjstmntAmtAndRsn,omitempty"`
+	RmtdAmt           *ActiveCurrencyAndAmount `xml:"RmtdAmt,omitempty"`
+}
+
+// CreditorReferenceInformation is the creditor's reference of a payment
+type CreditorReferenceInformation struct {
+	Tp  *DocumentType `xml:"Tp,omitempty"`
+	Ref string        `xml:"Ref,omitempty"`
+}
+
+// StructuredRemittanceInformation is remittance information in a structured form
+type StructuredRemittanceInformation struct {
+	RfrdDocInf  []ReferredDocumentInformation `xml:"RfrdDocInf,omitempty"`
+	RfrdDocAmt  *RemittanceAmount             `xml:"RfrdDocAmt,omitempty"`
+	CdtrRefInf  *CreditorReferenceInformation `xml:"CdtrRefInf,omitempty"`
+	Invcr       *PartyIdentification          `xml:"Invcr,omitempty"`
+	Invcee      *PartyIdentification          `xml:"Invcee,omitempty"`
+	AddtlRmtInf []string                      `xml:"AddtlRmtInf,omitempty"`
+}
+
+// RemittanceInformation is the remittance information of a payment
+type RemittanceInformation struct {
+	Ustrd []string                          `xml:"Ustrd,omitempty"`
+	Strd  []StructuredRemittanceInformation `xml:"Strd,omitempty"`
+}
+
+// unstructuredLineLength is the length of each line of OriginatorToBeneficiary {6000} and Remittance {7070}
+const unstructuredLineLength = 35
+
+// unstructured returns the unstructured remittance information of lines, each padded to unstructuredLineLength,
+// or nil when every line is empty
+func unstructured(lines ...string) []string {
+	var buf strings.Builder
+	for _, line := range lines {
+		buf.WriteString(fmt.Sprintf("%-*.*s", unstructuredLineLength, unstructuredLineLength, strings.TrimSpace(line)))
+	}
+	if ustrd := strings.TrimRight(buf.String(), " "); ustrd != "" {
+		return []string{ustrd}
+	}
+	return nil
+}
+
+// unstructuredLines splits the first unstructured remittance information of ustrd into n lines
+func unstructuredLines(ustrd []string, n int) []string {
+	lines := make([]string, n)
+	if len(ustrd) == 0 {
+		return lines
+	}
+	for i := range lines {
+		start := i * unstructuredLineLength
+		if start >= len(ustrd[0]) {
+			break
+		}
+		end := start + unstructuredLineLength
+		if end > len(ustrd[0]) {
+			end = len(ustrd[0])
+		}
+		lines[i] = strings.TrimSpace(ustrd[0][start:end])
+	}
+	return lines
+}
+
+// remittanceInformation returns the remittance information of OriginatorToBeneficiary {6000} and the
+// structured remittance tags {8300} to {8750}, or nil when fwm has none.
+func remittanceInformation(fwm *wire.FEDWireMessage) *RemittanceInformation {
+	ri := &RemittanceInformation{}
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		ri.Ustrd = unstructured(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour)
+	}
+	if strd := structuredRemittance(fwm); strd != nil {
+		ri.Strd = []StructuredRemittanceInformation{*strd}
+	}
+	if ri.Ustrd == nil && ri.Strd == nil {
+		return nil
+	}
+	return ri
+}
+
+// setRemittanceInformation sets OriginatorToBeneficiary {6000} and the structured remittance tags of fwm
+func setRemittanceInformation(fwm *wire.FEDWireMessage, ri *RemittanceInformation) {
+	if ri == nil {
+		return
+	}
+	if len(ri.Ustrd) > 0 {
+		lines := unstructuredLines(ri.Ustrd, 4)
+		ob := wire.NewOriginatorToBeneficiary()
+		ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = lines[0], lines[1], lines[2], lines[3]
+		fwm.SetOriginatorToBeneficiary(ob)
+	}
+	if len(ri.Strd) > 0 {
+		setStructuredRemittance(fwm, &ri.Strd[0])
+	}
+}
+
+// amountOf returns the ISO 20022 amount of a remittance amount
+func amountOf(ra wire.RemittanceAmount) ActiveCurrencyAndAmount {
+	return ActiveCurrencyAndAmount{Ccy: ra.CurrencyCode, Value: strings.TrimSpace(ra.Amount)}
+}
+
+// remittanceAmountOf returns the remittance amount of an ISO 20022 amount
+func remittanceAmountOf(amt *ActiveCurrencyAndAmount) wire.RemittanceAmount {
+	if amt == nil {
+		return wire.RemittanceAmount{}
+	}
+	return wire.RemittanceAmount{CurrencyCode: amt.Ccy, Amount: amt.Value}
+}
+
+// documentType returns the type of a remittance document, or nil when it has no type
+func documentType(code, proprietaryCode, issuer string) *DocumentType {
+	if code == "" && proprietaryCode == "" && issuer == "" {
+		return nil
+	}
+	tp := &DocumentType{Issr: strings.TrimSpace(issuer)}
+	if code == wire.ProprietaryDocumentType {
+		tp.CdOrPrtry.Prtry = strings.TrimSpace(proprietaryCode)
+	} else {
+		tp.CdOrPrtry.Cd = code
+	}
+	return tp
+}
+
+// documentCodes returns the type code, proprietary type code and issuer of tp
+func documentCodes(tp *DocumentType) (string, string, string) {
+	switch {
+	case tp == nil:
+		return "", "", ""
+	case tp.CdOrPrtry.Prtry != "":
+		return wire.ProprietaryDocumentType, tp.CdOrPrtry.Prtry, tp.Issr
+	}
+	return tp.CdOrPrtry.Cd, "", tp.Issr
+}
+
+// structuredAddress returns the postal address of RemittanceData
+func structuredAddress(rd wire.RemittanceData) *PostalAddress {
+	adr := postalAddress(rd.AddressLineOne, rd.AddressLineTwo, rd.AddressLineThree, rd.AddressLineFour,
+		rd.AddressLineFive, rd.AddressLineSix, rd.AddressLineSeven)
+	if adr == nil {
+		adr = &PostalAddress{}
+	}
+	if rd.AddressType != "" {
+		adr.AdrTp = &CodeOrProprietary{Cd: rd.AddressType}
+	}
+	adr.Dept = strings.TrimSpace(rd.Department)
+	adr.SubDept = strings.TrimSpace(rd.SubDepartment)
+	adr.StrtNm = strings.TrimSpace(rd.StreetName)
+	adr.BldgNb = strings.TrimSpace(rd.BuildingNumber)
+	adr.PstCd = strings.TrimSpace(rd.PostCode)
+	adr.TwnNm = strings.TrimSpace(rd.TownName)
+	adr.CtrySubDvsn = strings.TrimSpace(rd.CountrySubDivisionState)
+	adr.Ctry = strings.TrimSpace(rd.Country)
+	if reflect.DeepEqual(*adr, PostalAddress{}) {
+		return nil
+	}
+	return adr
+}
+
+// remittanceData returns the RemittanceData of a name and structured postal address
+func remittanceData(name string, adr *PostalAddress) wire.RemittanceData {
+	rd := wire.RemittanceData{Name: name}
+	if adr == nil {
+		return rd
+	}
+	rd.AddressType = proprietaryValue(adr.AdrTp)
+	rd.Department = adr.Dept
+	rd.SubDepartment = adr.SubDept
+	rd.StreetName = adr.StrtNm
+	rd.BuildingNumber = adr.BldgNb
+	rd.PostCode = adr.PstCd
+	rd.TownName = adr.TwnNm
+	rd.CountrySubDivisionState = adr.CtrySubDvsn
+	rd.Country = adr.Ctry
+	rd.AddressLineOne = addressLine(adr, 0)
+	rd.AddressLineTwo = addressLine(adr, 1)
+	rd.AddressLineThree = addressLine(adr, 2)
+	rd.AddressLineFour = addressLine(adr, 3)
+	rd.AddressLineFive = addressLine(adr, 4)
+	rd.AddressLineSix = addressLine(adr, 5)
+	rd.AddressLineSeven = addressLine(adr, 6)
+	return rd
+}
+
+// remittanceParty returns the party of a remittance originator or beneficiary
+func remittanceParty(rd wire.RemittanceData, idType, idCode, idNumber, issuer string) *PartyIdentification {
+	pty := &PartyIdentification{
+		Nm:        strings.TrimSpace(rd.Name),
+		PstlAdr:   structuredAddress(rd),
+		CtryOfRes: strings.TrimSpace(rd.CountryOfResidence),
+	}
+	if idCode != "" || strings.TrimSpace(idNumber) != "" {
+		id := []GenericIdentification{{
+			Id:      strings.TrimSpace(idNumber),
+			SchmeNm: &CodeOrProprietary{Cd: idCode},
+			Issr:    strings.TrimSpace(issuer),
+		}}
+		if idType == wire.PrivateID {
+			pty.Id = &Party{PrvtId: &PersonIdentification{Othr: id}}
+		} else {
+			pty.Id = &Party{OrgId: &OrganisationIdentification{Othr: id}}
+		}
+	}
+	return pty
+}
+
+// remittancePartyIdentification returns the identification type, code, number and issuer of a remittance
+// originator or beneficiary
+func remittancePartyIdentification(pty *PartyIdentification) (string, string, string, string) {
+	if pty.Id == nil {
+		return "", "", "", ""
+	}
+	idType, ids := wire.OrganizationID, []GenericIdentification(nil)
+	if pty.Id.OrgId != nil {
+		ids = pty.Id.OrgId.Othr
+	} else if pty.Id.PrvtId != nil {
+		idType, ids = wire.PrivateID, pty.Id.PrvtId.Othr
+	}
+	if len(ids) == 0 {
+		return idType, "", "", ""
+	}
+	return idType, proprietaryValue(ids[0].SchmeNm), ids[0].Id, ids[0].Issr
+}
+
+// structuredRemittance returns the structured remittance information of the tags {8300} to {8750}, or nil
+// when fwm has none of them.
+func structuredRemittance(fwm *wire.FEDWireMessage) *StructuredRemittanceInformation {
+	strd := &StructuredRemittanceInformation{}
+	found := false
+	if ro := fwm.RemittanceOriginator; ro != nil {
+		found = true
+		strd.Invcr = remittanceParty(ro.RemittanceData, ro.IdentificationType, ro.IdentificationCode,
+			ro.IdentificationNumber, ro.IdentificationNumberIssuer)
+		contact := Contact{
+			Nm:       strings.TrimSpace(ro.ContactName),
+			PhneNb:   strings.TrimSpace(ro.ContactPhoneNumber),
+			MobNb:    strings.TrimSpace(ro.ContactMobileNumber),
+			FaxNb:    strings.TrimSpace(ro.ContactFaxNumber),
+			EmailAdr: strings.TrimSpace(ro.ContactElectronicAddress),
+		}
+		if other := strings.TrimSpace(ro.ContactOther); other != "" {
+			contact.Othr = &OtherContact{ChanlTp: "OTHR", Id: other}
+		}
+		if contact != (Contact{}) {
+			strd.Invcr.CtctDtls = &contact
+		}
+	}
+	if rb := fwm.RemittanceBeneficiary; rb != nil {
+		found = true
+		strd.Invcee = remittanceParty(rb.RemittanceData, rb.IdentificationType, rb.IdentificationCode,
+			rb.IdentificationNumber, rb.IdentificationNumberIssuer)
+	}
+	if prd := fwm.PrimaryRemittanceDocument; prd != nil {
+		found = true
+		strd.RfrdDocInf = []ReferredDocumentInformation{{
+			Tp: documentType(prd.DocumentTypeCode, prd.ProprietaryDocumentTypeCode, prd.Issuer),
+			Nb: strings.TrimSpace(prd.DocumentIdentificationNumber),
+		}}
+	}
+	if drd := fwm.DateRemittanceDocument; drd != nil {
+		found = true
+		if len(strd.RfrdDocInf) == 0 {
+			strd.RfrdDocInf = []ReferredDocumentInformation{{}}
+		}
+		strd.RfrdDocInf[0].RltdDt = isoDate(drd.DateRemittanceDocument)
+	}
+	amt := &RemittanceAmount{}
+	if gross := fwm.GrossAmountRemittanceDocument; gross != nil {
+		a := amountOf(gross.RemittanceAmount)
+		amt.DuePyblAmt = &a
+	}
+	if discount := fwm.AmountNegotiatedDiscount; discount != nil {
+		amt.DscntApldAmt = []DiscountAmountAndType{{Amt: amountOf(discount.RemittanceAmount)}}
+	}
+	if adj := fwm.Adjustment; adj != nil {
+		amt.AdjstmntAmtAndRsn = []DocumentAdjustment{{
+			Amt:       amountOf(adj.RemittanceAmount),
+			CdtDbtInd: adj.CreditDebitIndicator,
+			Rsn:       strings.TrimSpace(adj.AdjustmentReasonCode),
+			AddtlInf:  strings.TrimSpace(adj.AdditionalInfo),
+		}}
+	}
+	if paid := fwm.ActualAmountPaid; paid != nil {
+		a := amountOf(paid.RemittanceAmount)
+		amt.RmtdAmt = &a
+	}
+	if amt.DuePyblAmt != nil || amt.DscntApldAmt != nil || amt.AdjstmntAmtAndRsn != nil || amt.RmtdAmt != nil {
+		found = true
+		strd.RfrdDocAmt = amt
+	}
+	if srd := fwm.SecondaryRemittanceDocument; srd != nil {
+		found = true
+		strd.CdtrRefInf = &CreditorReferenceInformation{
+			Tp:  documentType(srd.DocumentTypeCode, srd.ProprietaryDocumentTypeCode, srd.Issuer),
+			Ref: strings.TrimSpace(srd.DocumentIdentificationNumber),
+		}
+	}
+	if rft := fwm.RemittanceFreeText; rft != nil {
+		found = true
+		for _, line := range []string{rft.LineOne, rft.LineTwo, rft.LineThree} {
+			strd.AddtlRmtInf = append(strd.AddtlRmtInf, strings.TrimSpace(line))
+		}
+		for len(strd.AddtlRmtInf) > 0 && strd.AddtlRmtInf[len(strd.AddtlRmtInf)-1] == "" {
+			strd.AddtlRmtInf = strd.AddtlRmtInf[:len(strd.AddtlRmtInf)-1]
+		}
+	}
+	if !found {
+		return nil
+	}
+	return strd
+}
+
+// setStructuredRemittance sets the tags {8300} to {8750} of fwm from structured remittance information
+func setStructuredRemittance(fwm *wire.FEDWireMessage, strd *StructuredRemittanceInformation) {
+	if pty := strd.Invcr; pty != nil {
+		ro := wire.NewRemittanceOriginator()
+		ro.IdentificationType, ro.IdentificationCode, ro.IdentificationNumber, ro.IdentificationNumberIssuer =
+			remittancePartyIdentification(pty)
+		ro.RemittanceData = remittanceData(pty.Nm, pty.PstlAdr)
+		ro.RemittanceData.CountryOfResidence = pty.CtryOfRes
+		if c := pty.CtctDtls; c != nil {
+			ro.ContactName = c.Nm
+			ro.ContactPhoneNumber = c.PhneNb
+			ro.ContactMobileNumber = c.MobNb
+			ro.ContactFaxNumber = c.FaxNb
+			ro.ContactElectronicAddress = c.EmailAdr
+			if c.Othr != nil {
+				ro.ContactOther = c.Othr.Id
+			}
+		}
+		fwm.SetRemittanceOriginator(ro)
+	}
+	if pty := strd.Invcee; pty != nil {
+		rb := wire.NewRemittanceBeneficiary()
+		rb.IdentificationType, rb.IdentificationCode, rb.IdentificationNumber, rb.IdentificationNumberIssuer =
+			remittancePartyIdentification(pty)
+		rb.RemittanceData = remittanceData(pty.Nm, pty.PstlAdr)
+		rb.RemittanceData.CountryOfResidence = pty.CtryOfRes
+		fwm.SetRemittanceBeneficiary(rb)
+	}
+	if len(strd.RfrdDocInf) > 0 {
+		doc := strd.RfrdDocInf[0]
+		if doc.Tp != nil || doc.Nb != "" {
+			prd := wire.NewPrimaryRemittanceDocument()
+			prd.DocumentTypeCode, prd.ProprietaryDocumentTypeCode, prd.Issuer = documentCodes(doc.Tp)
+			prd.DocumentIdentificationNumber = doc.Nb
+			fwm.SetPrimaryRemittanceDocument(prd)
+		}
+		if doc.RltdDt != "" {
+			drd := wire.NewDateRemittanceDocument()
+			drd.DateRemittanceDocument = wireDate(doc.RltdDt)
+			fwm.SetDateRemittanceDocument(drd)
+		}
+	}
+	if amt := strd.RfrdDocAmt; amt != nil {
+		if amt.DuePyblAmt != nil {
+			gross := wire.NewGrossAmountRemittanceDocument()
+			gross.RemittanceAmount = remittanceAmountOf(amt.DuePyblAmt)
+			fwm.SetGrossAmountRemittanceDocument(gross)
+		}
+		if len(amt.DscntApldAmt) > 0 {
+			discount := wire.NewAmountNegotiatedDiscount()
+			discount.RemittanceAmount = remittanceAmountOf(&amt.DscntApldAmt[0].Amt)
+			fwm.SetAmountNegotiatedDiscount(discount)
+		}
+		if len(amt.AdjstmntAmtAndRsn) > 0 {
+			a := amt.AdjstmntAmtAndRsn[0]
+			adj := wire.NewAdjustment()
+			adj.RemittanceAmount = remittanceAmountOf(&a.Amt)
+			adj.CreditDebitIndicator = a.CdtDbtInd
+			adj.AdjustmentReasonCode = a.Rsn
+			adj.AdditionalInfo = a.AddtlInf
+			fwm.SetAdjustment(adj)
+		}
+		if amt.RmtdAmt != nil {
+			paid := wire.NewActualAmountPaid()
+			paid.RemittanceAmount = remittanceAmountOf(amt.RmtdAmt)
+			fwm.SetActualAmountPaid(paid)
+		}
+	}
+	if ref := strd.CdtrRefInf; ref != nil {
+		srd := wire.NewSecondaryRemittanceDocument()
+		srd.DocumentTypeCode, srd.ProprietaryDocumentTypeCode, srd.Issuer = documentCodes(ref.Tp)
+		srd.DocumentIdentificationNumber = ref.Ref
+		fwm.SetSecondaryRemittanceDocument(srd)
+	}
+	if len(strd.AddtlRmtInf) > 0 {
+		line := func(i int) string {
+			if i < len(strd.AddtlRmtInf) {
+				return strd.AddtlRmtInf[i]
+			}
+			return ""
+		}
+		rft := wire.NewRemittanceFreeText()
+		rft.LineOne, rft.LineTwo, rft.LineThree = line(0), line(1), line(2)
+		fwm.SetRemittanceFreeText(rft)
+	}
+}
+
+// relatedRemittance returns the remittance location of RelatedRemittance {8250}, or nil when fwm has none
+func relatedRemittance(fwm *wire.FEDWireMessage) []RemittanceLocation {
+	rr := fwm.RelatedRemittance
+	if rr == nil {
+		return nil
+	}
+	data := RemittanceLocationData{
+		Mtd:        rr.RemittanceLocationMethod,
+		ElctrncAdr: strings.TrimSpace(rr.RemittanceLocationElectronicAddress),
+	}
+	if name, adr := strings.TrimSpace(rr.RemittanceData.Name), structuredAddress(rr.RemittanceData); name != "" || adr != nil {
+		data.PstlAdr = &NameAndAddress{Nm: name}
+		if adr != nil {
+			data.PstlAdr.Adr = *adr
+		}
+	}
+	return []RemittanceLocation{{
+		RmtId:       strings.TrimSpace(rr.RemittanceIdentification),
+		RmtLctnDtls: []RemittanceLocationData{data},
+	}}
+}
+
+// setRelatedRemittance sets RelatedRemittance {8250} of fwm from a remittance location
+func setRelatedRemittance(fwm *wire.FEDWireMessage, rl []RemittanceLocation) {
+	if len(rl) == 0 {
+		return
+	}
+	rr := wire.NewRelatedRemittance()
+	rr.RemittanceIdentification = rl[0].RmtId
+	if len(rl[0].RmtLctnDtls) > 0 {
+		data := rl[0].RmtLctnDtls[0]
+		rr.RemittanceLocationMethod = data.Mtd
+		rr.RemittanceLocationElectronicAddress = data.ElctrncAdr
+		if data.PstlAdr != nil {
+			rr.RemittanceData = remittanceData(data.PstlAdr.Nm, &data.PstlAdr.Adr)
+		}
+	}
+	fwm.SetRelatedRemittance(rr)
+}
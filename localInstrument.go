@@ -32,6 +32,11 @@ func NewLocalInstrument() *LocalInstrument {
 	return li
 }
 
+// setTag sets the tag of LocalInstrument, which JSON does not hold
+func (li *LocalInstrument) setTag() {
+	li.tag = TagLocalInstrument
+}
+
 // Parse takes the input string and parses the LocalInstrument values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -4,7 +4,10 @@
 
 package wire
 
-import "strings"
+import (
+	"strings"
+	"unicode/utf8"
+)
 
 // MessageDisposition is the message disposition of the wire
 type MessageDisposition struct {
@@ -20,7 +23,7 @@ type MessageDisposition struct {
 	MessageStatusIndicator string `json:"messageStatusIndicator,omitempty"`
 
 	// validator is composed for data validation
-	// validator
+	validator
 	// converters is composed for WIRE to GoLang Converters
 	converters
 }
@@ -36,16 +39,25 @@ func NewMessageDisposition() *MessageDisposition {
 	return md
 }
 
+// setTag sets the tag of MessageDisposition, which JSON does not hold
+func (md *MessageDisposition) setTag() {
+	md.tag = TagMessageDisposition
+}
+
 // Parse takes the input string and parses the MessageDisposition values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
-func (md *MessageDisposition) Parse(record string) {
+func (md *MessageDisposition) Parse(record string) error {
+	if utf8.RuneCountInString(record) != 11 {
+		return NewTagWrongLengthErr(11, utf8.RuneCountInString(record))
+	}
 	md.tag = record[:6]
 	md.FormatVersion = md.parseStringField(record[6:8])
 	md.TestProductionCode = md.parseStringField(record[8:9])
 	md.MessageDuplicationCode = md.parseStringField(record[9:10])
 	md.MessageStatusIndicator = md.parseStringField(record[10:11])
+	return nil
 }
 
 // String writes MessageDisposition
@@ -63,10 +75,25 @@ func (md *MessageDisposition) String() string {
 // Validate performs WIRE format rule checks on MessageDisposition and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (md *MessageDisposition) Validate() error {
-	// Currently no validation as the FED is responsible for the values
 	if md.tag != TagMessageDisposition {
 		return fieldError("tag", ErrValidTagForType, md.tag)
 	}
+	if md.FormatVersion != FormatVersion {
+		return fieldError("FormatVersion", ErrFormatVersion, md.FormatVersion)
+	}
+	if md.TestProductionCode != "" {
+		if err := md.isTestProductionCode(md.TestProductionCode); err != nil {
+			return fieldError("TestProductionCode", err, md.TestProductionCode)
+		}
+	}
+	if err := md.isDispositionDuplicationCode(md.MessageDuplicationCode); err != nil {
+		return fieldError("MessageDuplicationCode", err, md.MessageDuplicationCode)
+	}
+	if md.MessageStatusIndicator != "" {
+		if err := md.isMessageStatusIndicator(md.MessageStatusIndicator); err != nil {
+			return fieldError("MessageStatusIndicator", err, md.MessageStatusIndicator)
+		}
+	}
 	return nil
 }
 
@@ -83,3 +83,44 @@ func TestMessageDispositionTagError(t *testing.T) {
 		}
 	}
 }
+
+// TestMessageDispositionFormatVersionValid validates MessageDisposition FormatVersion
+func TestMessageDispositionFormatVersionValid(t *testing.T) {
+	md := mockMessageDisposition()
+	md.FormatVersion = "55"
+	if err := md.Validate(); !base.Match(err, ErrFormatVersion) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestMessageDispositionMessageDuplicationCodeValid validates MessageDisposition MessageDuplicationCode
+func TestMessageDispositionMessageDuplicationCodeValid(t *testing.T) {
+	md := mockMessageDisposition()
+	md.MessageDuplicationCode = MessageDuplicationRetrieval
+	if err := md.Validate(); err != nil {
+		t.Errorf("%T: %s", err, err)
+	}
+	md.MessageDuplicationCode = "Z"
+	if err := md.Validate(); !base.Match(err, ErrMessageDuplicationCode) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestMessageDispositionMessageStatusIndicatorValid validates MessageDisposition MessageStatusIndicator
+func TestMessageDispositionMessageStatusIndicatorValid(t *testing.T) {
+	md := mockMessageDisposition()
+	md.MessageStatusIndicator = "Z"
+	if err := md.Validate(); !base.Match(err, ErrMessageStatusIndicator) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestParseMessageDispositionWrongLength parses a wrong MessageDisposition record length
+func TestParseMessageDispositionWrongLength(t *testing.T) {
+	var line = "{1100}30P"
+	r := NewReader(strings.NewReader(line))
+	r.line = line
+	if err := r.parseMessageDisposition(); !base.Match(err, NewTagWrongLengthErr(11, 9)) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
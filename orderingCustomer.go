@@ -30,6 +30,11 @@ func NewOrderingCustomer() *OrderingCustomer {
 	return oc
 }
 
+// setTag sets the tag of OrderingCustomer, which JSON does not hold
+func (oc *OrderingCustomer) setTag() {
+	oc.tag = TagOrderingCustomer
+}
+
 // Parse takes the input string and parses the OrderingCustomer values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -30,6 +30,11 @@ func NewOrderingInstitution() *OrderingInstitution {
 	return oi
 }
 
+// setTag sets the tag of OrderingInstitution, which JSON does not hold
+func (oi *OrderingInstitution) setTag() {
+	oi.tag = TagOrderingInstitution
+}
+
 // Parse takes the input string and parses the OrderingInstitution values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
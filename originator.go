@@ -30,6 +30,11 @@ func NewOriginator() *Originator {
 	return o
 }
 
+// setTag sets the tag of Originator, which JSON does not hold
+func (o *Originator) setTag() {
+	o.tag = TagOriginator
+}
+
 // Parse takes the input string and parses the Originator values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -43,6 +48,7 @@ func (o *Originator) Parse(record string) error {
 	o.Personal.Identifier = o.parseStringField(record[7:41])
 	o.Personal.Name = o.parseStringField(record[41:76])
 	o.Personal.Address.AddressLineOne = o.parseStringField(record[76:111])
+	o.Personal.Address.AddressLineTwo = o.parseStringField(record[111:146])
 	o.Personal.Address.AddressLineThree = o.parseStringField(record[146:181])
 	return nil
 }
@@ -77,6 +83,9 @@ func (o *Originator) Validate() error {
 	if err := o.isAlphanumeric(o.Personal.Identifier); err != nil {
 		return fieldError("Identifier", err, o.Personal.Identifier)
 	}
+	if err := o.isIdentifier(o.Personal.IdentificationCode, o.Personal.Identifier); err != nil {
+		return fieldError("Identifier", err, o.Personal.Identifier)
+	}
 	if err := o.isAlphanumeric(o.Personal.Name); err != nil {
 		return fieldError("Name", err, o.Personal.Name)
 	}
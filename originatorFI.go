@@ -30,6 +30,11 @@ func NewOriginatorFI() *OriginatorFI {
 	return ofi
 }
 
+// setTag sets the tag of OriginatorFI, which JSON does not hold
+func (ofi *OriginatorFI) setTag() {
+	ofi.tag = TagOriginatorFI
+}
+
 // Parse takes the input string and parses the OriginatorFI values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -84,6 +89,9 @@ func (ofi *OriginatorFI) Validate() error {
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Identifier); err != nil {
 		return fieldError("Identifier", err, ofi.FinancialInstitution.Identifier)
 	}
+	if err := ofi.isIdentifier(ofi.FinancialInstitution.IdentificationCode, ofi.FinancialInstitution.Identifier); err != nil {
+		return fieldError("Identifier", err, ofi.FinancialInstitution.Identifier)
+	}
 	if err := ofi.isAlphanumeric(ofi.FinancialInstitution.Name); err != nil {
 		return fieldError("Name", err, ofi.FinancialInstitution.Name)
 	}
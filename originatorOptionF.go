@@ -94,6 +94,11 @@ func NewOriginatorOptionF() *OriginatorOptionF {
 	return oof
 }
 
+// setTag sets the tag of OriginatorOptionF, which JSON does not hold
+func (oof *OriginatorOptionF) setTag() {
+	oof.tag = TagOriginatorOptionF
+}
+
 // Parse takes the input string and parses the OriginatorOptionF values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
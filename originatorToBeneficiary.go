@@ -36,6 +36,11 @@ func NewOriginatorToBeneficiary() *OriginatorToBeneficiary {
 	return ob
 }
 
+// setTag sets the tag of OriginatorToBeneficiary, which JSON does not hold
+func (ob *OriginatorToBeneficiary) setTag() {
+	ob.tag = TagOriginatorToBeneficiary
+}
+
 // Parse takes the input string and parses the OriginatorToBeneficiary values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
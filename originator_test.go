@@ -162,3 +162,18 @@ func TestOriginatorTagError(t *testing.T) {
 		}
 	}
 }
+
+// TestOriginatorIdentifierBIC validates an Originator Identifier is a BIC and account number when the
+// IdentificationCode is SWIFTBICORBEIANDAccountNumber
+func TestOriginatorIdentifierBIC(t *testing.T) {
+	o := mockOriginator()
+	o.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
+	o.Personal.Identifier = "DEUTDEFF/DE89370400440532013000"
+	if err := o.Validate(); err != nil {
+		t.Errorf("%T: %s", err, err)
+	}
+	o.Personal.Identifier = "DEUTDEF/DE89370400440532013000"
+	if err := o.Validate(); !base.Match(err, ErrBIC) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
@@ -4,7 +4,10 @@
 
 package wire
 
-import "strings"
+import (
+	"strings"
+	"unicode/utf8"
+)
 
 // OutputMessageAccountabilityData is the Output Message Accountability Data (OMAD) of the wire
 type OutputMessageAccountabilityData struct {
@@ -24,7 +27,7 @@ type OutputMessageAccountabilityData struct {
 	OutputFRBApplicationIdentification string `json:"outputFRBApplicationIdentification,omitempty"`
 
 	// validator is composed for data validation
-	// validator
+	validator
 	// converters is composed for WIRE to GoLang Converters
 	converters
 }
@@ -37,11 +40,19 @@ func NewOutputMessageAccountabilityData() *OutputMessageAccountabilityData {
 	return omad
 }
 
+// setTag sets the tag of OutputMessageAccountabilityData, which JSON does not hold
+func (omad *OutputMessageAccountabilityData) setTag() {
+	omad.tag = TagOutputMessageAccountabilityData
+}
+
 // Parse takes the input string and parses the OutputMessageAccountabilityData values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
 // successful parsing and data validity.
-func (omad *OutputMessageAccountabilityData) Parse(record string) {
+func (omad *OutputMessageAccountabilityData) Parse(record string) error {
+	if utf8.RuneCountInString(record) != 40 {
+		return NewTagWrongLengthErr(40, utf8.RuneCountInString(record))
+	}
 	omad.tag = record[:6]
 	omad.OutputCycleDate = omad.parseStringField(record[6:14])
 	omad.OutputDestinationID = omad.parseStringField(record[14:22])
@@ -49,6 +60,7 @@ func (omad *OutputMessageAccountabilityData) Parse(record string) {
 	omad.OutputDate = omad.parseStringField(record[28:32])
 	omad.OutputTime = omad.parseStringField(record[32:36])
 	omad.OutputFRBApplicationIdentification = omad.parseStringField(record[36:40])
+	return nil
 }
 
 // String writes OutputMessageAccountabilityData
@@ -68,10 +80,27 @@ func (omad *OutputMessageAccountabilityData) String() string {
 // Validate performs WIRE format rule checks on OutputMessageAccountabilityData and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (omad *OutputMessageAccountabilityData) Validate() error {
-	// Currently no validation as the FED is responsible for the values
 	if omad.tag != TagOutputMessageAccountabilityData {
 		return fieldError("tag", ErrValidTagForType, omad.tag)
 	}
+	if err := omad.validateDate(omad.OutputCycleDate); err != nil {
+		return fieldError("OutputCycleDate", err, omad.OutputCycleDate)
+	}
+	if err := omad.isAlphanumeric(omad.OutputDestinationID); err != nil {
+		return fieldError("OutputDestinationID", err, omad.OutputDestinationID)
+	}
+	if err := omad.isNumeric(omad.OutputSequenceNumber); err != nil {
+		return fieldError("OutputSequenceNumber", err, omad.OutputSequenceNumber)
+	}
+	if err := omad.validateMonthDay(omad.OutputDate); err != nil {
+		return fieldError("OutputDate", err, omad.OutputDate)
+	}
+	if err := omad.validateTime(omad.OutputTime); err != nil {
+		return fieldError("OutputTime", err, omad.OutputTime)
+	}
+	if err := omad.isAlphanumeric(omad.OutputFRBApplicationIdentification); err != nil {
+		return fieldError("OutputFRBApplicationIdentification", err, omad.OutputFRBApplicationIdentification)
+	}
 	return nil
 }
 
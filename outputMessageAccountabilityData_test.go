@@ -91,3 +91,40 @@ func TestOutputMessageAccountabilityDataTagError(t *testing.T) {
 		}
 	}
 }
+
+// TestOutputMessageAccountabilityDataOutputCycleDateValid validates OutputMessageAccountabilityData OutputCycleDate
+func TestOutputMessageAccountabilityDataOutputCycleDateValid(t *testing.T) {
+	omad := mockOutputMessageAccountabilityData()
+	omad.OutputCycleDate = "20191302"
+	if err := omad.Validate(); !base.Match(err, ErrValidDate) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestOutputMessageAccountabilityDataOutputSequenceNumberNumeric validates OutputMessageAccountabilityData
+// OutputSequenceNumber is numeric
+func TestOutputMessageAccountabilityDataOutputSequenceNumberNumeric(t *testing.T) {
+	omad := mockOutputMessageAccountabilityData()
+	omad.OutputSequenceNumber = "00000Z"
+	if err := omad.Validate(); !base.Match(err, ErrNonNumeric) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestOutputMessageAccountabilityDataOutputDateValid validates OutputMessageAccountabilityData OutputDate
+func TestOutputMessageAccountabilityDataOutputDateValid(t *testing.T) {
+	omad := mockOutputMessageAccountabilityData()
+	omad.OutputDate = "1301"
+	if err := omad.Validate(); !base.Match(err, ErrValidDate) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestOutputMessageAccountabilityDataOutputTimeValid validates OutputMessageAccountabilityData OutputTime
+func TestOutputMessageAccountabilityDataOutputTimeValid(t *testing.T) {
+	omad := mockOutputMessageAccountabilityData()
+	omad.OutputTime = "12"
+	if err := omad.Validate(); !base.Match(err, NewTagWrongLengthErr(4, 2)) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
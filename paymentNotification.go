@@ -44,6 +44,11 @@ func NewPaymentNotification() *PaymentNotification {
 	return pn
 }
 
+// setTag sets the tag of PaymentNotification, which JSON does not hold
+func (pn *PaymentNotification) setTag() {
+	pn.tag = TagPaymentNotification
+}
+
 // Parse takes the input string and parses the PaymentNotification values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -30,6 +30,11 @@ func NewPreviousMessageIdentifier() *PreviousMessageIdentifier {
 	return pmi
 }
 
+// setTag sets the tag of PreviousMessageIdentifier, which JSON does not hold
+func (pmi *PreviousMessageIdentifier) setTag() {
+	pmi.tag = TagPreviousMessageIdentifier
+}
+
 // Parse takes the input string and parses the PreviousMessageIdentifier values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -36,6 +36,11 @@ func NewPrimaryRemittanceDocument() *PrimaryRemittanceDocument {
 	return prd
 }
 
+// setTag sets the tag of PrimaryRemittanceDocument, which JSON does not hold
+func (prd *PrimaryRemittanceDocument) setTag() {
+	prd.tag = TagPrimaryRemittanceDocument
+}
+
 // Parse takes the input string and parses the PrimaryRemittanceDocument values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -8,6 +8,7 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/moov-io/base"
@@ -24,15 +25,31 @@ type Reader struct {
 	// ToDo:  Do we need a current FEDWireMessage, just use FEDWireMessage
 	// currentFEDWireMessage is the current FEDWireMessage being parsed
 	currentFEDWireMessage FEDWireMessage
+	// messageLines is the number of lines read into currentFEDWireMessage
+	messageLines int
+	// outputLines is the number of lines of messageLines which are tags appended by the Fedwire Funds Service
+	outputLines int
+	// text is line as read, before a tag in the delimited format is expanded
+	text string
+	// unread is true when line has been read but belongs to the next FEDWireMessage
+	unread bool
 	// lineNum is the line number of the file being parsed
 	lineNum int
 	// tagName holds the current tag name being parsed.
 	tagName string
+	// strictTags requires the tags of a FEDWireMessage to be in the FAIM order and not repeated
+	strictTags bool
+	// tagsRead are the tags read into currentFEDWireMessage
+	tagsRead map[string]bool
+	// previousTag is the index in messageTags of the tag last read into currentFEDWireMessage
+	previousTag int
+	// preserveUnknownTags keeps the tags which are not recognised as UnknownTags of the FEDWireMessage
+	preserveUnknownTags bool
 	// errors holds each error encountered when attempting to parse the file
 	errors base.ErrorList
 }
 
-// error returns a new ParseError based on err
+// parseError returns a new ParseError based on err, locating err within the line read with a ColumnError
 func (r *Reader) parseError(err error) error {
 	if err == nil {
 		return nil
@@ -43,53 +60,186 @@ func (r *Reader) parseError(err error) error {
 	return &base.ParseError{
 		Line:   r.lineNum,
 		Record: r.tagName,
-		Err:    err,
+		Err:    newColumnError(r.text, err),
+	}
+}
+
+// ReaderOptionFunc sets an option of a Reader
+type ReaderOptionFunc func(*Reader)
+
+// StrictTags reports a tag out of the FAIM order or repeated within a FEDWireMessage as a base.ParseError.
+// Otherwise the tags of a FEDWireMessage are read in any order, keeping the last of a repeated tag.
+func StrictTags(strict bool) ReaderOptionFunc {
+	return func(r *Reader) {
+		r.strictTags = strict
+	}
+}
+
+// PreserveUnknownTags keeps each tag which is not recognised, such as a new or proprietary tag of a
+// counterparty, as an UnknownTag of its FEDWireMessage along with its position, so the Writer can re-emit it in
+// place. Otherwise a tag which is not recognised is an ErrInvalidTag.
+func PreserveUnknownTags(preserve bool) ReaderOptionFunc {
+	return func(r *Reader) {
+		r.preserveUnknownTags = preserve
 	}
 }
 
 // NewReader returns a new ACH Reader that reads from r.
-func NewReader(r io.Reader) *Reader {
-	return &Reader{
+func NewReader(r io.Reader, opts ...ReaderOptionFunc) *Reader {
+	reader := &Reader{
 		scanner: bufio.NewScanner(r),
 	}
+	for _, opt := range opts {
+		opt(reader)
+	}
+	return reader
 }
 
-// addCurrentFEDWireMessage creates the current FEDWireMessage for the file being read. A successful
-// current FEDWireMessage will be added to r.File once parsed.
-/*func (r *Reader) addCurrentFEDWireMessage(fwm FEDWireMessage) {
-	r.currentFEDWireMessage = FEDWireMessage{}
-}*/
-
 // Read reads each line of the FED Wire file and defines which parser to use based
 // on the first character of each line. It also enforces FED Wire formatting rules and returns
 // the appropriate error if issues are found.
+//
+// Read holds every FEDWireMessage of the file in memory, use Next to read large files one FEDWireMessage at a time.
 func (r *Reader) Read() (File, error) {
-	r.lineNum = 0
-	// read through the entire file
-	for r.scanner.Scan() {
-		line := r.scanner.Text()
-		r.lineNum++
-		// ToDo: File length Check?
-		r.line = line
-		if err := r.parseLine(); err != nil {
+	for {
+		fwm, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if fwm != nil {
+			r.File.AddFEDWireMessage(*fwm)
+		}
+		if el, ok := err.(base.ErrorList); ok {
+			r.errors = append(r.errors, el...)
+		} else if err != nil {
 			r.errors.Add(err)
+			break
 		}
 	}
 
-	r.File.AddFEDWireMessage(r.currentFEDWireMessage)
-	r.currentFEDWireMessage = NewFEDWireMessage()
-
 	if r.errors.Empty() {
 		return r.File, nil
 	}
 	return r.File, r.errors
 }
 
+// Next reads the next FEDWireMessage, which begins with a SenderSupplied {1500} tag, without retaining it in
+// the Reader. When any tags of the FEDWireMessage fail to parse, the FEDWireMessage is returned along with a
+// base.ErrorList of each error and the following FEDWireMessage may still be read by calling Next again.
+//
+// io.EOF is returned once there are no more FEDWireMessages to read.
+func (r *Reader) Next() (*FEDWireMessage, error) {
+	r.currentFEDWireMessage = NewFEDWireMessage()
+	r.messageLines = 0
+	r.outputLines = 0
+	r.tagsRead = make(map[string]bool)
+	r.previousTag = -1
+
+	var errs base.ErrorList
+	for r.scanLine() {
+		// each SenderSupplied tag begins a new FEDWireMessage, as do the tags the Fedwire Funds Service appends
+		// ahead of the SenderSupplied tag of an output message
+		if r.messageLines > r.outputLines && (strings.HasPrefix(r.line, TagSenderSupplied) || isOutputTag(r.line)) {
+			r.unread = true
+			break
+		}
+		r.messageLines++
+		if isOutputTag(r.line) {
+			r.outputLines++
+		}
+		if err := r.parseLine(); err != nil {
+			errs.Add(err)
+		}
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	if r.messageLines == 0 {
+		return nil, io.EOF
+	}
+
+	fwm := r.currentFEDWireMessage
+	r.currentFEDWireMessage = NewFEDWireMessage()
+	if errs.Empty() {
+		return &fwm, nil
+	}
+	return &fwm, errs
+}
+
+// isOutputTag returns true when line is one of the tags the Fedwire Funds Service appends to an output message:
+// MessageDisposition {1100}, ReceiptTimeStamp {1110}, OutputMessageAccountabilityData {1120} and ErrorWire {1130}
+func isOutputTag(line string) bool {
+	switch {
+	case strings.HasPrefix(line, TagMessageDisposition), strings.HasPrefix(line, TagReceiptTimeStamp),
+		strings.HasPrefix(line, TagOutputMessageAccountabilityData), strings.HasPrefix(line, TagErrorWire):
+		return true
+	}
+	return false
+}
+
+// checkTagOrder returns a base.ParseError when tag has already been read into the current FEDWireMessage or
+// belongs before the tag last read, when the Reader is strict. Tags which are not known are not checked.
+func (r *Reader) checkTagOrder(tag string) error {
+	index := tagIndex(tag)
+	if !r.strictTags || index < 0 {
+		return nil
+	}
+	r.tagName = messageTags[index].Name
+	if r.tagsRead[tag] {
+		return r.parseError(NewErrDuplicateTag(tag))
+	}
+	if index < r.previousTag {
+		return r.parseError(NewErrTagOutOfOrder(tag, messageTags[r.previousTag].Tag))
+	}
+	r.tagsRead[tag] = true
+	r.previousTag = index
+	return nil
+}
+
+// scanLine advances r.line to the next line of input, unless the current line has been unread.
+func (r *Reader) scanLine() bool {
+	if r.unread {
+		r.unread = false
+		return true
+	}
+	if !r.scanner.Scan() {
+		return false
+	}
+	r.line = r.scanner.Text()
+	r.lineNum++
+	return true
+}
+
 func (r *Reader) parseLine() error {
+	r.text = r.line
+	r.tagName = ""
 	if n := utf8.RuneCountInString(r.line); n < 6 {
-		return fmt.Errorf("line %q is too short for tag", r.line)
+		return r.parseError(fmt.Errorf("line %q is too short for tag", r.line))
+	}
+	// Tags in the delimited format are expanded to the fixed width format before parsing
+	if record, ok := expandDelimited(r.line); ok {
+		r.line = record
+	}
+	if err := r.checkTagOrder(r.line[:6]); err != nil {
+		return err
 	}
 	switch r.line[:6] {
+	case TagMessageDisposition:
+		if err := r.parseMessageDisposition(); err != nil {
+			return err
+		}
+	case TagReceiptTimeStamp:
+		if err := r.parseReceiptTimeStamp(); err != nil {
+			return err
+		}
+	case TagOutputMessageAccountabilityData:
+		if err := r.parseOutputMessageAccountabilityData(); err != nil {
+			return err
+		}
+	case TagErrorWire:
+		if err := r.parseErrorWire(); err != nil {
+			return err
+		}
 	case TagSenderSupplied:
 		if err := r.parseSenderSupplied(); err != nil {
 			return err
@@ -316,11 +466,24 @@ func (r *Reader) parseLine() error {
 			return err
 		}
 	default:
-		return NewErrInvalidTag(r.line[:6])
+		if r.preserveUnknownTags && isTag(r.line[:6]) {
+			r.parseUnknownTag()
+			return nil
+		}
+		return r.parseError(NewErrInvalidTag(r.line[:6]))
 	}
 	return nil
 }
 
+// parseUnknownTag keeps the current line verbatim as an UnknownTag of the current FEDWireMessage
+func (r *Reader) parseUnknownTag() {
+	r.currentFEDWireMessage.UnknownTags = append(r.currentFEDWireMessage.UnknownTags, UnknownTag{
+		Tag:      r.line[:6],
+		Value:    r.line[6:],
+		Position: r.messageLines - 1,
+	})
+}
+
 func (r *Reader) parseSenderSupplied() error {
 	r.tagName = "SenderSupplied"
 	ss := new(SenderSupplied)
@@ -1050,7 +1213,9 @@ func (r *Reader) parseServiceMessage() error {
 func (r *Reader) parseMessageDisposition() error {
 	r.tagName = "MessageDisposition"
 	md := new(MessageDisposition)
-	md.Parse(r.line)
+	if err := md.Parse(r.line); err != nil {
+		return r.parseError(err)
+	}
 	if err := md.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1061,7 +1226,9 @@ func (r *Reader) parseMessageDisposition() error {
 func (r *Reader) parseReceiptTimeStamp() error {
 	r.tagName = "ReceiptTimeStamp"
 	rts := new(ReceiptTimeStamp)
-	rts.Parse(r.line)
+	if err := rts.Parse(r.line); err != nil {
+		return r.parseError(err)
+	}
 	if err := rts.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1072,7 +1239,9 @@ func (r *Reader) parseReceiptTimeStamp() error {
 func (r *Reader) parseOutputMessageAccountabilityData() error {
 	r.tagName = "OutputMessageAccountabilityData"
 	omad := new(OutputMessageAccountabilityData)
-	omad.Parse(r.line)
+	if err := omad.Parse(r.line); err != nil {
+		return r.parseError(err)
+	}
 	if err := omad.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1083,7 +1252,9 @@ func (r *Reader) parseOutputMessageAccountabilityData() error {
 func (r *Reader) parseErrorWire() error {
 	r.tagName = "ErrorWire"
 	ew := new(ErrorWire)
-	ew.Parse(r.line)
+	if err := ew.Parse(r.line); err != nil {
+		return r.parseError(err)
+	}
 	if err := ew.Validate(); err != nil {
 		return r.parseError(err)
 	}
@@ -1,6 +1,9 @@
 package wire
 
 import (
+	"bytes"
+	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -25,6 +28,7 @@ func TestRead(t *testing.T) {
 	t.Run("CustomerTransferPlusCOVS", testRead(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransferPlusCOVS.txt")))
 	t.Run("CustomerTransferPlusUnstructuredAddenda", testRead(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransferPlusUnstructuredAddenda.txt")))
 	t.Run("CustomerTransferPlusStructuredRemittance", testRead(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt")))
+	t.Run("MultipleMessages", testRead(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt")))
 }
 
 func testRead(filePathName string) func(t *testing.T) {
@@ -63,9 +67,193 @@ func TestReadInvalidTag(t *testing.T) {
 	}
 }
 
+// TestReadMultipleMessages validates each SenderSupplied tag begins a new FEDWireMessage
+func TestReadMultipleMessages(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	file, err := NewReader(f).Read()
+	if err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if n := len(file.FEDWireMessages); n != 2 {
+		t.Fatalf("got %d FEDWireMessages", n)
+	}
+	if bfc := file.FEDWireMessages[0].BusinessFunctionCode.BusinessFunctionCode; bfc != BankTransfer {
+		t.Errorf("first BusinessFunctionCode=%s", bfc)
+	}
+	if bfc := file.FEDWireMessages[1].BusinessFunctionCode.BusinessFunctionCode; bfc != CustomerTransfer {
+		t.Errorf("second BusinessFunctionCode=%s", bfc)
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).Write(&file); err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	read, err := NewReader(strings.NewReader(buf.String())).Read()
+	if err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if n := len(read.FEDWireMessages); n != 2 {
+		t.Errorf("got %d FEDWireMessages after writing", n)
+	}
+}
+
+// TestReaderNext validates an invalid FEDWireMessage does not prevent reading the following FEDWireMessage
+// TestReadOutputMessages validates the tags the Fedwire Funds Service appends ahead of SenderSupplied {1500}
+// belong to the FEDWireMessage which follows them
+func TestReadOutputMessages(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := strings.TrimRight(string(bs), "\r\n") + "\n"
+	accepted := "{1100}30P N\n{1110}04101230FT03\n{1120}20190410ABCD1234000001041012300FT1\n"
+	rejected := "{1100}30P N\n{1110}04101231FT03\n{1120}20190410ABCD1234000002041012310FT1\n{1130}E301Invalid amount                     \n"
+
+	file, err := NewReader(strings.NewReader(accepted + message + rejected + message)).Read()
+	if err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if n := len(file.FEDWireMessages); n != 2 {
+		t.Fatalf("got %d FEDWireMessages", n)
+	}
+	first, second := file.FEDWireMessages[0], file.FEDWireMessages[1]
+	if first.OutputMessageAccountabilityData.OutputSequenceNumber != "000001" || first.ErrorWire != nil || first.SenderSupplied == nil {
+		t.Errorf("unexpected first FEDWireMessage: %v %v", first.OutputMessageAccountabilityData, first.ErrorWire)
+	}
+	if second.OutputMessageAccountabilityData.OutputSequenceNumber != "000002" || second.ErrorWire.ErrorCode != "301" {
+		t.Errorf("unexpected second FEDWireMessage: %v %v", second.OutputMessageAccountabilityData, second.ErrorWire)
+	}
+	if second.ReceiptTimeStamp.ReceiptTime != "1231" || second.MessageDisposition.MessageStatusIndicator != "N" {
+		t.Errorf("unexpected second FEDWireMessage: %v %v", second.ReceiptTimeStamp, second.MessageDisposition)
+	}
+
+	if _, err := NewReader(strings.NewReader("{1120}20190410\n" + message)).Read(); !base.Has(err, NewTagWrongLengthErr(40, 14)) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+func TestReaderNext(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// an invalid Amount in the first FEDWireMessage
+	input := strings.Replace(string(bs), "{2000}", "{2000}X", 1)
+
+	r := NewReader(strings.NewReader(input))
+	fwm, err := r.Next()
+	if fwm == nil || fwm.SenderSupplied == nil {
+		t.Fatalf("expected FEDWireMessage: %#v", fwm)
+	}
+	if !base.Has(err, NewTagWrongLengthErr(18, 19)) {
+		t.Errorf("%T: %s", err, err)
+	}
+
+	fwm, err = r.Next()
+	if err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if fwm.BusinessFunctionCode.BusinessFunctionCode != CustomerTransfer {
+		t.Errorf("BusinessFunctionCode=%s", fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+
+	if fwm, err := r.Next(); fwm != nil || err != io.EOF {
+		t.Errorf("expected io.EOF: %v", err)
+	}
+	if len(r.File.FEDWireMessages) != 0 {
+		t.Error("Next shouldn't retain FEDWireMessages")
+	}
+}
+
+func TestReaderNextEmpty(t *testing.T) {
+	if fwm, err := NewReader(strings.NewReader("")).Next(); fwm != nil || err != io.EOF {
+		t.Errorf("expected io.EOF: %v", err)
+	}
+}
+
 func TestReadShortLine(t *testing.T) {
 	f, err := NewReader(strings.NewReader("00")).Read()
 	if err == nil {
 		t.Errorf("expected error, file=%v", f)
 	}
 }
+
+// readCustomerTransfer returns the lines of the sample CustomerTransfer
+func readCustomerTransfer(t *testing.T) []string {
+	t.Helper()
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimRight(string(bs), "\r\n"), "\n")
+}
+
+// TestReadDuplicateTag reports a repeated tag with its line number
+func TestReadDuplicateTag(t *testing.T) {
+	lines := readCustomerTransfer(t)
+	// repeat {3320} after {3500}
+	lines = append(lines[:9], append([]string{lines[7]}, lines[9:]...)...)
+	input := strings.Join(lines, "\n")
+
+	_, err := NewReader(strings.NewReader(input), StrictTags(true)).Read()
+	if !base.Has(err, NewErrDuplicateTag(TagSenderReference)) {
+		t.Fatalf("%T: %v", err, err)
+	}
+	if el, ok := err.(base.ErrorList); !ok || len(el) == 0 {
+		t.Fatalf("%T: %v", err, err)
+	} else if pe, ok := el[0].(*base.ParseError); !ok || pe.Line != 10 || pe.Record != "SenderReference" {
+		t.Errorf("%T: %v", el[0], el[0])
+	}
+
+	fwm, err := NewReader(strings.NewReader(input)).Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fwm.SenderReference == nil || fwm.SenderReference.SenderReference != "Sender Reference" {
+		t.Errorf("unexpected SenderReference: %v", fwm.SenderReference)
+	}
+}
+
+// TestReadTagOutOfOrder reports a tag which belongs before the tag preceding it
+func TestReadTagOutOfOrder(t *testing.T) {
+	lines := readCustomerTransfer(t)
+	// swap {3400} and {3600}
+	lines[5], lines[6] = lines[6], lines[5]
+	input := strings.Join(lines, "\n")
+
+	_, err := NewReader(strings.NewReader(input), StrictTags(true)).Read()
+	if !base.Has(err, NewErrTagOutOfOrder(TagReceiverDepositoryInstitution, TagBusinessFunctionCode)) {
+		t.Fatalf("%T: %v", err, err)
+	}
+	if el, ok := err.(base.ErrorList); !ok || len(el) == 0 {
+		t.Fatalf("%T: %v", err, err)
+	} else if pe, ok := el[0].(*base.ParseError); !ok || pe.Line != 7 || pe.Record != "ReceiverDepositoryInstitution" {
+		t.Errorf("%T: %v", el[0], el[0])
+	}
+
+	file, err := NewReader(strings.NewReader(input)).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := file.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestReadTagOrderPerMessage checks the order of tags within each FEDWireMessage of a File
+func TestReadTagOrderPerMessage(t *testing.T) {
+	message := strings.Join(readCustomerTransfer(t), "\n")
+	input := message + "\n" + strings.Replace(message, "Source08000001", "Source08000002", 1)
+	file, err := NewReader(strings.NewReader(input), StrictTags(true)).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(file.FEDWireMessages); n != 2 {
+		t.Errorf("read %d FEDWireMessages", n)
+	}
+}
@@ -21,7 +21,7 @@ type ReceiptTimeStamp struct {
 	ReceiptApplicationIdentification string `json:"receiptApplicationIdentification,omitempty"`
 
 	// validator is composed for data validation
-	// validator
+	validator
 	// converters is composed for WIRE to GoLang Converters
 	converters
 }
@@ -34,6 +34,11 @@ func NewReceiptTimeStamp() *ReceiptTimeStamp {
 	return rts
 }
 
+// setTag sets the tag of ReceiptTimeStamp, which JSON does not hold
+func (rts *ReceiptTimeStamp) setTag() {
+	rts.tag = TagReceiptTimeStamp
+}
+
 // Parse takes the input string and parses the ReceiptTimeStamp values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -64,10 +69,18 @@ func (rts *ReceiptTimeStamp) String() string {
 // Validate performs WIRE format rule checks on ReceiptTimeStamp and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (rts *ReceiptTimeStamp) Validate() error {
-	// Currently no validation as the FED is responsible for the values
 	if rts.tag != TagReceiptTimeStamp {
 		return fieldError("tag", ErrValidTagForType, rts.tag)
 	}
+	if err := rts.validateMonthDay(rts.ReceiptDate); err != nil {
+		return fieldError("ReceiptDate", err, rts.ReceiptDate)
+	}
+	if err := rts.validateTime(rts.ReceiptTime); err != nil {
+		return fieldError("ReceiptTime", err, rts.ReceiptTime)
+	}
+	if err := rts.isAlphanumeric(rts.ReceiptApplicationIdentification); err != nil {
+		return fieldError("ReceiptApplicationIdentification", err, rts.ReceiptApplicationIdentification)
+	}
 	return nil
 }
 
@@ -79,3 +79,31 @@ func TestReceiptTimeStampTagError(t *testing.T) {
 		}
 	}
 }
+
+// TestReceiptTimeStampReceiptDateValid validates ReceiptTimeStamp ReceiptDate
+func TestReceiptTimeStampReceiptDateValid(t *testing.T) {
+	rts := mockReceiptTimeStamp()
+	rts.ReceiptDate = "0231"
+	if err := rts.Validate(); !base.Match(err, ErrValidDate) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestReceiptTimeStampReceiptTimeValid validates ReceiptTimeStamp ReceiptTime
+func TestReceiptTimeStampReceiptTimeValid(t *testing.T) {
+	rts := mockReceiptTimeStamp()
+	rts.ReceiptTime = "2460"
+	if err := rts.Validate(); !base.Match(err, ErrValidTime) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestReceiptTimeStampReceiptApplicationIdentificationAlphaNumeric validates ReceiptTimeStamp
+// ReceiptApplicationIdentification is alphanumeric
+func TestReceiptTimeStampReceiptApplicationIdentificationAlphaNumeric(t *testing.T) {
+	rts := mockReceiptTimeStamp()
+	rts.ReceiptApplicationIdentification = "®"
+	if err := rts.Validate(); !base.Match(err, ErrNonAlphanumeric) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
@@ -32,6 +32,11 @@ func NewReceiverDepositoryInstitution() *ReceiverDepositoryInstitution {
 	return rdi
 }
 
+// setTag sets the tag of ReceiverDepositoryInstitution, which JSON does not hold
+func (rdi *ReceiverDepositoryInstitution) setTag() {
+	rdi.tag = TagReceiverDepositoryInstitution
+}
+
 // Parse takes the input string and parses the ReceiverDepositoryInstitution values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -65,7 +70,7 @@ func (rdi *ReceiverDepositoryInstitution) Validate() error {
 	if rdi.tag != TagReceiverDepositoryInstitution {
 		return fieldError("tag", ErrValidTagForType, rdi.tag)
 	}
-	if err := rdi.isNumeric(rdi.ReceiverABANumber); err != nil {
+	if err := rdi.isRoutingNumber(rdi.ReceiverABANumber); err != nil {
 		return fieldError("ReceiverABANumber", err, rdi.ReceiverABANumber)
 	}
 	if err := rdi.isAlphanumeric(rdi.ReceiverShortName); err != nil {
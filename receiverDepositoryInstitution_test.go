@@ -114,3 +114,12 @@ func TestReceiverDepositoryInstitutionTagError(t *testing.T) {
 		}
 	}
 }
+
+// TestReceiverABANumberCheckDigit validates ReceiverDepositoryInstitution ReceiverABANumber has a valid check digit
+func TestReceiverABANumberCheckDigit(t *testing.T) {
+	rdi := mockReceiverDepositoryInstitution()
+	rdi.ReceiverABANumber = "23138010"
+	if err := rdi.Validate(); !base.Match(err, ErrRoutingNumber) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
@@ -36,6 +36,11 @@ func NewRelatedRemittance() *RelatedRemittance {
 	return rr
 }
 
+// setTag sets the tag of RelatedRemittance, which JSON does not hold
+func (rr *RelatedRemittance) setTag() {
+	rr.tag = TagRelatedRemittance
+}
+
 // Parse takes the input string and parses the RelatedRemittance values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
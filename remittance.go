@@ -30,6 +30,11 @@ func NewRemittance() *Remittance {
 	return ri
 }
 
+// setTag sets the tag of Remittance, which JSON does not hold
+func (ri *Remittance) setTag() {
+	ri.tag = TagRemittance
+}
+
 // Parse takes the input string and parses the Remittance values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
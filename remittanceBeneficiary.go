@@ -38,6 +38,11 @@ func NewRemittanceBeneficiary() *RemittanceBeneficiary {
 	return rb
 }
 
+// setTag sets the tag of RemittanceBeneficiary, which JSON does not hold
+func (rb *RemittanceBeneficiary) setTag() {
+	rb.tag = TagRemittanceBeneficiary
+}
+
 // Parse takes the input string and parses the RemittanceBeneficiary values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -0,0 +1,225 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// isRemittanceConsistent validates the structured remittance tags {8250} - {8750} against each other, where
+// isRemittanceValid validates each against the LocalInstrument. The remittance amounts must share a currency,
+// ActualAmountPaid {8450} must be GrossAmountRemittanceDocument {8500} less AmountNegotiatedDiscount {8550},
+// plus a credit or minus a debit Adjustment {8600}, and a USD ActualAmountPaid must not exceed the Amount {2000}.
+// DateRemittanceDocument {8650} requires the PrimaryRemittanceDocument {8400} it dates, a CNFA or DNFA
+// PrimaryRemittanceDocument requires a credit or debit Adjustment, and SecondaryRemittanceDocument {8700} must
+// not repeat PrimaryRemittanceDocument.
+//
+// The checks are intentionally limited to what the tags themselves say. Not checked are:
+//   - DateRemittanceDocument against the cycle date or any other date
+//   - the DocumentTypeCode of SecondaryRemittanceDocument against that of PrimaryRemittanceDocument
+//   - ProprietaryDocumentTypeCode, which has no meaning to the Fedwire Funds Service
+//   - the sign of ActualAmountPaid for credit and debit notes other than CNFA and DNFA
+//   - RemittanceOriginator {8300} and RemittanceBeneficiary {8350} against Originator {5000} and Beneficiary {4200}
+func (fwm *FEDWireMessage) isRemittanceConsistent() error {
+	var errs ruleErrors
+	errs.add(fwm.isRelatedRemittanceStructured())
+	if err := fwm.isRemittanceCurrencyValid(); err != nil {
+		// amounts in different currencies cannot be compared
+		errs.add(err)
+	} else {
+		errs.add(fwm.isRemittanceAmountPaidValid())
+		errs.add(fwm.isRemittanceAmountExceeded())
+	}
+	errs.add(fwm.isRemittanceDocumentValid())
+	return errs.err()
+}
+
+// remittanceAmount is a RemittanceAmount of a structured remittance tag
+type remittanceAmount struct {
+	name string
+	RemittanceAmount
+}
+
+// remittanceAmounts returns the RemittanceAmount of each structured remittance tag defined, in tag order
+func (fwm *FEDWireMessage) remittanceAmounts() []remittanceAmount {
+	var amounts []remittanceAmount
+	if fwm.ActualAmountPaid != nil {
+		amounts = append(amounts, remittanceAmount{"ActualAmountPaid", fwm.ActualAmountPaid.RemittanceAmount})
+	}
+	if fwm.GrossAmountRemittanceDocument != nil {
+		amounts = append(amounts, remittanceAmount{"GrossAmountRemittanceDocument", fwm.GrossAmountRemittanceDocument.RemittanceAmount})
+	}
+	if fwm.AmountNegotiatedDiscount != nil {
+		amounts = append(amounts, remittanceAmount{"AmountNegotiatedDiscount", fwm.AmountNegotiatedDiscount.RemittanceAmount})
+	}
+	if fwm.Adjustment != nil {
+		amounts = append(amounts, remittanceAmount{"Adjustment", fwm.Adjustment.RemittanceAmount})
+	}
+	return amounts
+}
+
+// isRemittanceCurrencyValid validates each remittance amount is in the currency of the first
+func (fwm *FEDWireMessage) isRemittanceCurrencyValid() error {
+	var errs ruleErrors
+	amounts := fwm.remittanceAmounts()
+	for i := 1; i < len(amounts); i++ {
+		if amounts[i].CurrencyCode != amounts[0].CurrencyCode {
+			errs.add(NewErrRemittanceConflict(amounts[i].name, amounts[i].CurrencyCode,
+				[]string{amounts[0].name}, amounts[0].CurrencyCode, ErrRemittanceCurrency))
+		}
+	}
+	return errs.err()
+}
+
+// isRemittanceAmountPaidValid validates ActualAmountPaid is GrossAmountRemittanceDocument less
+// AmountNegotiatedDiscount, plus a credit or minus a debit Adjustment. Amounts which do not parse are left to
+// the validation of their tag.
+func (fwm *FEDWireMessage) isRemittanceAmountPaidValid() error {
+	if fwm.ActualAmountPaid == nil || fwm.GrossAmountRemittanceDocument == nil {
+		return nil
+	}
+	paid, ok := parseRemittanceAmount(fwm.ActualAmountPaid.RemittanceAmount.Amount)
+	if !ok {
+		return nil
+	}
+	expected, ok := parseRemittanceAmount(fwm.GrossAmountRemittanceDocument.RemittanceAmount.Amount)
+	if !ok {
+		return nil
+	}
+	conflicting := []string{"GrossAmountRemittanceDocument"}
+	if fwm.AmountNegotiatedDiscount != nil {
+		discount, ok := parseRemittanceAmount(fwm.AmountNegotiatedDiscount.RemittanceAmount.Amount)
+		if !ok {
+			return nil
+		}
+		expected.Sub(expected, discount)
+		conflicting = append(conflicting, "AmountNegotiatedDiscount")
+	}
+	if fwm.Adjustment != nil {
+		adjustment, ok := parseRemittanceAmount(fwm.Adjustment.RemittanceAmount.Amount)
+		if !ok {
+			return nil
+		}
+		switch fwm.Adjustment.CreditDebitIndicator {
+		case CreditIndicator:
+			expected.Add(expected, adjustment)
+		case DebitIndicator:
+			expected.Sub(expected, adjustment)
+		default:
+			return nil
+		}
+		conflicting = append(conflicting, "Adjustment")
+	}
+	if paid.Cmp(expected) != 0 {
+		currencyCode := fwm.ActualAmountPaid.RemittanceAmount.CurrencyCode
+		return NewErrRemittanceConflict("ActualAmountPaid", remittanceAmountString(fwm.ActualAmountPaid.RemittanceAmount),
+			conflicting, currencyCode+formatRemittanceAmount(expected), ErrRemittanceAmountPaid)
+	}
+	return nil
+}
+
+// isRemittanceAmountExceeded validates a USD ActualAmountPaid does not exceed the Amount {2000}, which is always
+// in USD
+func (fwm *FEDWireMessage) isRemittanceAmountExceeded() error {
+	if fwm.ActualAmountPaid == nil || fwm.Amount == nil || fwm.ActualAmountPaid.RemittanceAmount.CurrencyCode != "USD" {
+		return nil
+	}
+	paid, ok := parseRemittanceAmount(fwm.ActualAmountPaid.RemittanceAmount.Amount)
+	if !ok {
+		return nil
+	}
+	cents, err := strconv.ParseInt(fwm.Amount.Amount, 10, 64)
+	if err != nil {
+		return nil
+	}
+	amount := big.NewRat(cents, 100)
+	if paid.Cmp(amount) > 0 {
+		return NewErrRemittanceConflict("ActualAmountPaid", remittanceAmountString(fwm.ActualAmountPaid.RemittanceAmount),
+			[]string{"Amount"}, "USD"+formatRemittanceAmount(amount), ErrRemittanceExceedsAmount)
+	}
+	return nil
+}
+
+// isRemittanceDocumentValid validates the remittance documents are consistent: DateRemittanceDocument dates a
+// PrimaryRemittanceDocument, a CNFA or DNFA PrimaryRemittanceDocument has a credit or debit Adjustment, and
+// SecondaryRemittanceDocument is not PrimaryRemittanceDocument again, by type code and identification number
+func (fwm *FEDWireMessage) isRemittanceDocumentValid() error {
+	var errs ruleErrors
+	if fwm.DateRemittanceDocument != nil && fwm.PrimaryRemittanceDocument == nil {
+		errs.add(NewErrRemittanceConflict("DateRemittanceDocument", fwm.DateRemittanceDocument.DateRemittanceDocument,
+			[]string{"PrimaryRemittanceDocument"}, "", ErrRemittanceDocumentDate))
+	}
+	if fwm.PrimaryRemittanceDocument == nil {
+		return errs.err()
+	}
+	prd := fwm.PrimaryRemittanceDocument
+	indicator := ""
+	switch prd.DocumentTypeCode {
+	case CreditNoteRelatedFinancialAdjustment:
+		indicator = CreditIndicator
+	case DebitNoteRelatedFinancialAdjustment:
+		indicator = DebitIndicator
+	}
+	if indicator != "" && (fwm.Adjustment == nil || fwm.Adjustment.CreditDebitIndicator != indicator) {
+		errs.add(NewErrRemittanceConflict("PrimaryRemittanceDocument", prd.DocumentTypeCode,
+			[]string{"Adjustment"}, indicator, ErrRemittanceDocumentAdjustment))
+	}
+	if srd := fwm.SecondaryRemittanceDocument; srd != nil && prd.DocumentTypeCode == srd.DocumentTypeCode &&
+		strings.TrimSpace(prd.ProprietaryDocumentTypeCode) == strings.TrimSpace(srd.ProprietaryDocumentTypeCode) &&
+		strings.TrimSpace(prd.DocumentIdentificationNumber) == strings.TrimSpace(srd.DocumentIdentificationNumber) {
+		value := strings.Join(strings.Fields(srd.DocumentTypeCode+" "+srd.ProprietaryDocumentTypeCode+" "+srd.DocumentIdentificationNumber), " ")
+		errs.add(NewErrRemittanceConflict("SecondaryRemittanceDocument", value,
+			[]string{"PrimaryRemittanceDocument"}, "", ErrRemittanceDocument))
+	}
+	return errs.err()
+}
+
+// isRelatedRemittanceStructured validates RelatedRemittance, which refers to remittance sent outside the
+// FEDWireMessage, is not sent with structured remittance tags {8300} - {8750}
+func (fwm *FEDWireMessage) isRelatedRemittanceStructured() error {
+	if fwm.RelatedRemittance == nil {
+		return nil
+	}
+	var structured []string
+	for i := range messageTags {
+		tag := messageTags[i].Tag
+		if tag <= TagRelatedRemittance || tag > TagRemittanceFreeText {
+			continue
+		}
+		if fwm.tagValue(tag) != nil {
+			structured = append(structured, messageTags[i].Name)
+		}
+	}
+	if len(structured) > 0 {
+		return NewErrRemittanceConflict("RelatedRemittance", fwm.RelatedRemittance.RemittanceIdentification,
+			structured, "", ErrRemittanceStructured)
+	}
+	return nil
+}
+
+// parseRemittanceAmount parses the decimal Amount of a RemittanceAmount, e.g. 1234.56
+func parseRemittanceAmount(s string) (*big.Rat, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.Trim(s, "0123456789.") != "" {
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
+
+// remittanceAmountString returns the currency code and amount of ra, e.g. USD1234.56
+func remittanceAmountString(ra RemittanceAmount) string {
+	return ra.CurrencyCode + strings.TrimSpace(ra.Amount)
+}
+
+// formatRemittanceAmount formats r as a RemittanceAmount Amount, with two to five decimal places
+func formatRemittanceAmount(r *big.Rat) string {
+	s := r.FloatString(5)
+	for strings.HasSuffix(s, "0") && len(s)-strings.Index(s, ".") > 3 {
+		s = s[:len(s)-1]
+	}
+	return s
+}
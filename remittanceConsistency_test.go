@@ -0,0 +1,211 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/moov-io/base"
+)
+
+// mockStructuredRemittance returns a FEDWireMessage with consistent structured remittance tags: 1234.56 less a
+// 34.56 discount plus a 100.00 credit adjustment is an actual amount paid of 1300.00
+func mockStructuredRemittance() FEDWireMessage {
+	fwm := NewFEDWireMessage()
+	amt := mockAmount()
+	fwm.SetAmount(amt)
+	fwm.SetPrimaryRemittanceDocument(mockPrimaryRemittanceDocument())
+	aap := mockActualAmountPaid()
+	aap.RemittanceAmount.Amount = "1300.00"
+	fwm.SetActualAmountPaid(aap)
+	fwm.SetGrossAmountRemittanceDocument(mockGrossAmountRemittanceDocument())
+	nd := mockAmountNegotiatedDiscount()
+	nd.RemittanceAmount.Amount = "34.56"
+	fwm.SetAmountNegotiatedDiscount(nd)
+	adj := mockAdjustment()
+	adj.RemittanceAmount.Amount = "100"
+	fwm.SetAdjustment(adj)
+	fwm.SetSecondaryRemittanceDocument(mockSecondaryRemittanceDocument())
+	return fwm
+}
+
+// TestRemittanceConsistent validates mockStructuredRemittance
+func TestRemittanceConsistent(t *testing.T) {
+	fwm := mockStructuredRemittance()
+	if err := fwm.isRemittanceConsistent(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a debit adjustment is subtracted
+	fwm.Adjustment.CreditDebitIndicator = DebitIndicator
+	fwm.ActualAmountPaid.RemittanceAmount.Amount = "1100"
+	if err := fwm.isRemittanceConsistent(); err != nil {
+		t.Error(err)
+	}
+
+	// the amount paid is only checked against the gross amount
+	fwm.SetGrossAmountRemittanceDocument(nil)
+	fwm.ActualAmountPaid.RemittanceAmount.Amount = "12.34"
+	if err := fwm.isRemittanceConsistent(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestRemittanceAmountPaid validates ActualAmountPaid is the gross amount less discount and adjustment
+func TestRemittanceAmountPaid(t *testing.T) {
+	fwm := mockStructuredRemittance()
+	fwm.ActualAmountPaid.RemittanceAmount.Amount = "1234.56"
+	err := fwm.isRemittanceConsistent()
+	if !base.Match(err, ErrRemittanceAmountPaid) {
+		t.Fatalf("%T: %s", err, err)
+	}
+	expected := "ActualAmountPaid {8450} conflicts with GrossAmountRemittanceDocument {8500}, AmountNegotiatedDiscount {8550}, Adjustment {8600}: USD1234.56 is not the gross amount less discount and adjustment of USD1300.00"
+	if err.Error() != expected {
+		t.Errorf("got %q", err)
+	}
+}
+
+// TestRemittanceCurrency validates the remittance amounts share a currency
+func TestRemittanceCurrency(t *testing.T) {
+	fwm := mockStructuredRemittance()
+	fwm.Adjustment.RemittanceAmount.CurrencyCode = "EUR"
+	err := fwm.isRemittanceConsistent()
+	if !base.Match(err, ErrRemittanceCurrency) {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if err.Error() != "Adjustment {8600} conflicts with ActualAmountPaid {8450}: EUR is not the currency code of USD" {
+		t.Errorf("got %q", err)
+	}
+	// amounts in different currencies are not added up
+	if base.Has(err, ErrRemittanceAmountPaid) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// TestRemittanceExceedsAmount validates ActualAmountPaid does not exceed the Amount
+func TestRemittanceExceedsAmount(t *testing.T) {
+	fwm := mockStructuredRemittance()
+	fwm.Amount.Amount = "000000100000"
+	err := fwm.isRemittanceConsistent()
+	if !base.Match(err, ErrRemittanceExceedsAmount) {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if err.Error() != "ActualAmountPaid {8450} conflicts with Amount {2000}: USD1300.00 exceeds the amount of USD1000.00" {
+		t.Errorf("got %q", err)
+	}
+
+	// an ActualAmountPaid in another currency cannot be compared with the Amount
+	for _, ra := range []*RemittanceAmount{&fwm.ActualAmountPaid.RemittanceAmount, &fwm.GrossAmountRemittanceDocument.RemittanceAmount,
+		&fwm.AmountNegotiatedDiscount.RemittanceAmount, &fwm.Adjustment.RemittanceAmount} {
+		ra.CurrencyCode = "EUR"
+	}
+	if err := fwm.isRemittanceConsistent(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestRemittanceDocument validates SecondaryRemittanceDocument does not repeat PrimaryRemittanceDocument
+func TestRemittanceDocument(t *testing.T) {
+	fwm := mockStructuredRemittance()
+	fwm.SecondaryRemittanceDocument.DocumentTypeCode = fwm.PrimaryRemittanceDocument.DocumentTypeCode
+	fwm.SecondaryRemittanceDocument.DocumentIdentificationNumber = fwm.PrimaryRemittanceDocument.DocumentIdentificationNumber
+	err := fwm.isRemittanceConsistent()
+	if !base.Match(err, ErrRemittanceDocument) {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if err.Error() != "SecondaryRemittanceDocument {8700} conflicts with PrimaryRemittanceDocument {8400}: AROI 111111 repeats the document" {
+		t.Errorf("got %q", err)
+	}
+
+	// another document of the same type
+	fwm.SecondaryRemittanceDocument.DocumentIdentificationNumber = "222222"
+	if err := fwm.isRemittanceConsistent(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestRemittanceDocumentDate validates DateRemittanceDocument is sent with PrimaryRemittanceDocument
+func TestRemittanceDocumentDate(t *testing.T) {
+	fwm := mockStructuredRemittance()
+	drd := mockDateRemittanceDocument()
+	drd.DateRemittanceDocument = "20190415"
+	fwm.SetDateRemittanceDocument(drd)
+	if err := fwm.isRemittanceConsistent(); err != nil {
+		t.Fatal(err)
+	}
+
+	fwm.SetPrimaryRemittanceDocument(nil)
+	fwm.SetSecondaryRemittanceDocument(nil)
+	err := fwm.isRemittanceConsistent()
+	if !base.Match(err, ErrRemittanceDocumentDate) {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if err.Error() != "DateRemittanceDocument {8650} conflicts with PrimaryRemittanceDocument {8400}: 20190415 dates a document which is not identified" {
+		t.Errorf("got %q", err)
+	}
+}
+
+// TestRemittanceDocumentAdjustment validates a CNFA or DNFA PrimaryRemittanceDocument has an Adjustment in the
+// same direction
+func TestRemittanceDocumentAdjustment(t *testing.T) {
+	fwm := mockStructuredRemittance()
+	fwm.PrimaryRemittanceDocument.DocumentTypeCode = CreditNoteRelatedFinancialAdjustment
+	if err := fwm.isRemittanceConsistent(); err != nil {
+		t.Fatal(err)
+	}
+
+	fwm.PrimaryRemittanceDocument.DocumentTypeCode = DebitNoteRelatedFinancialAdjustment
+	err := fwm.isRemittanceConsistent()
+	if !base.Match(err, ErrRemittanceDocumentAdjustment) {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if err.Error() != "PrimaryRemittanceDocument {8400} conflicts with Adjustment {8600}: DNFA requires an Adjustment with CreditDebitIndicator DBIT" {
+		t.Errorf("got %q", err)
+	}
+
+	// a financial adjustment note without an Adjustment
+	fwm.SetAdjustment(nil)
+	fwm.ActualAmountPaid.RemittanceAmount.Amount = "1200.00"
+	if err := fwm.isRemittanceConsistent(); !base.Match(err, ErrRemittanceDocumentAdjustment) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestRemittanceRelatedRemittanceStructured validates RelatedRemittance is not sent with structured remittance
+func TestRemittanceRelatedRemittanceStructured(t *testing.T) {
+	fwm := NewFEDWireMessage()
+	fwm.SetRelatedRemittance(mockRelatedRemittance())
+	if err := fwm.isRemittanceConsistent(); err != nil {
+		t.Fatal(err)
+	}
+	fwm.SetPrimaryRemittanceDocument(mockPrimaryRemittanceDocument())
+	fwm.SetActualAmountPaid(mockActualAmountPaid())
+	fwm.SetDateRemittanceDocument(mockDateRemittanceDocument())
+	err := fwm.isRemittanceConsistent()
+	if !base.Match(err, ErrRemittanceStructured) {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if err.Error() != "RelatedRemittance {8250} conflicts with PrimaryRemittanceDocument {8400}, ActualAmountPaid {8450}, DateRemittanceDocument {8650}: Remittance Identification cannot be sent with structured remittance" {
+		t.Errorf("got %q", err)
+	}
+}
+
+// TestRemittanceConflictValidationErrors validates each conflict is reported against the tag in conflict
+func TestRemittanceConflictValidationErrors(t *testing.T) {
+	fwm := mockStructuredRemittance()
+	fwm.AmountNegotiatedDiscount.RemittanceAmount.CurrencyCode = "CAD"
+	fwm.Adjustment.RemittanceAmount.CurrencyCode = "EUR"
+	var errs ValidationErrors
+	errs.add(0, "", fwm.isRemittanceConsistent())
+	if len(errs) != 2 {
+		t.Fatalf("unexpected ValidationErrors: %v", errs)
+	}
+	if errs[0].Tag != TagAmountNegotiatedDiscount || errs[0].Field != "" || errs[0].Value != "CAD" {
+		t.Errorf("unexpected ValidationError: %#v", errs[0])
+	}
+	if errs[1].Tag != TagAdjustment || errs[1].Value != "EUR" {
+		t.Errorf("unexpected ValidationError: %#v", errs[1])
+	}
+}
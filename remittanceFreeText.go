@@ -34,6 +34,11 @@ func NewRemittanceFreeText() *RemittanceFreeText {
 	return rft
 }
 
+// setTag sets the tag of RemittanceFreeText, which JSON does not hold
+func (rft *RemittanceFreeText) setTag() {
+	rft.tag = TagRemittanceFreeText
+}
+
 // Parse takes the input string and parses the RemittanceFreeText values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -50,6 +50,11 @@ func NewRemittanceOriginator() *RemittanceOriginator {
 	return ro
 }
 
+// setTag sets the tag of RemittanceOriginator, which JSON does not hold
+func (ro *RemittanceOriginator) setTag() {
+	ro.tag = TagRemittanceOriginator
+}
+
 // Parse takes the input string and parses the RemittanceOriginator values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
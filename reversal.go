@@ -0,0 +1,112 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"strings"
+)
+
+// NewReversalRequest returns a non-value request for reversal of original, sent by the sender of original to
+// its receiver. The SubTypeCode is RequestReversal (01), or RequestReversalPriorDayTransfer (07) when imad is
+// on a later cycle date than the IMAD of original.
+//
+// See newReversal for the tags which are carried from original. The returned FEDWireMessage is validated and
+// returned along with ValidationErrors when it is invalid.
+func NewReversalRequest(original *FEDWireMessage, imad *InputMessageAccountabilityData) (*FEDWireMessage, error) {
+	return newReversal(original, imad, "", RequestReversal, RequestReversalPriorDayTransfer, false)
+}
+
+// NewReversalTransfer returns a value reversal of original, which returns the funds of original to its sender
+// and may answer a request for reversal. The SubTypeCode is ReversalTransfer (02), or ReversalPriorDayTransfer
+// (08) when imad is on a later cycle date than the IMAD of original. The sender and receiver are swapped.
+//
+// See newReversal for the tags which are carried from original. The returned FEDWireMessage is validated and
+// returned along with ValidationErrors when it is invalid.
+func NewReversalTransfer(original *FEDWireMessage, imad *InputMessageAccountabilityData) (*FEDWireMessage, error) {
+	return newReversal(original, imad, "", ReversalTransfer, ReversalPriorDayTransfer, true)
+}
+
+// NewFEDFundsReturned returns a FEDFundsReturned (FFR) FEDWireMessage which returns the funds of original, a
+// FEDFundsSold (FFS) FEDWireMessage, to its sender. The sender and receiver are swapped.
+//
+// See newReversal for the tags which are carried from original. The returned FEDWireMessage is validated and
+// returned along with ValidationErrors when it is invalid.
+func NewFEDFundsReturned(original *FEDWireMessage, imad *InputMessageAccountabilityData) (*FEDWireMessage, error) {
+	if original.BusinessFunctionCode != nil && original.BusinessFunctionCode.BusinessFunctionCode != FEDFundsSold {
+		return nil, fieldError("BusinessFunctionCode", ErrBusinessFunctionCode, original.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	return newReversal(original, imad, FEDFundsReturned, BasicFundsTransfer, BasicFundsTransfer, true)
+}
+
+// newReversal returns a copy of original which refers to it. The copy has the InputMessageAccountabilityData
+// (IMAD) imad, the TypeCode of original and subTypeCode, or priorDaySubTypeCode when imad is on a later cycle
+// date than the IMAD of original. PreviousMessageIdentifier {3500} is the IMAD of original and the
+// BusinessFunctionCode is businessFunctionCode, when not empty. When swap is true the sender {3100} and receiver
+// {3400} are swapped.
+//
+// Every other tag of original is carried except SenderReference {3320}, which the sender of the copy assigns,
+// and the tags appended by the Fedwire Funds Service. The SenderSupplied {1500} of the copy is an original
+// message, not a resend.
+func newReversal(original *FEDWireMessage, imad *InputMessageAccountabilityData, businessFunctionCode, subTypeCode, priorDaySubTypeCode string, swap bool) (*FEDWireMessage, error) {
+	if err := original.isMandatory(); err != nil {
+		return nil, err
+	}
+	if imad == nil {
+		return nil, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	fwm, err := original.copy()
+	if err != nil {
+		return nil, err
+	}
+
+	fwm.SenderSupplied.MessageDuplicationCode = MessageDuplicationOriginal
+	fwm.SetInputMessageAccountabilityData(imad)
+	fwm.TypeSubType.SubTypeCode = subTypeCode
+	if imad.InputCycleDate > original.InputMessageAccountabilityData.InputCycleDate {
+		fwm.TypeSubType.SubTypeCode = priorDaySubTypeCode
+	}
+	if businessFunctionCode != "" {
+		fwm.BusinessFunctionCode.BusinessFunctionCode = businessFunctionCode
+	}
+	if swap {
+		sdi := NewSenderDepositoryInstitution()
+		sdi.SenderABANumber = original.ReceiverDepositoryInstitution.ReceiverABANumber
+		sdi.SenderShortName = original.ReceiverDepositoryInstitution.ReceiverShortName
+		rdi := NewReceiverDepositoryInstitution()
+		rdi.ReceiverABANumber = original.SenderDepositoryInstitution.SenderABANumber
+		rdi.ReceiverShortName = original.SenderDepositoryInstitution.SenderShortName
+		fwm.SetSenderDepositoryInstitution(sdi)
+		fwm.SetReceiverDepositoryInstitution(rdi)
+	}
+	pmi := NewPreviousMessageIdentifier()
+	pmi.PreviousMessageIdentifier = original.InputMessageAccountabilityData.imadField()
+	fwm.SetPreviousMessageIdentifier(pmi)
+	fwm.SenderReference = nil
+
+	if errs := fwm.ValidateAll(); len(errs) > 0 {
+		return fwm, errs
+	}
+	return fwm, nil
+}
+
+// copy returns a copy of fwm which shares no tags with fwm. The tags appended by the Fedwire Funds Service are
+// not copied, and UnknownTags are.
+func (fwm *FEDWireMessage) copy() (*FEDWireMessage, error) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, StripOutputTags(true))
+	if err := w.writeFEDWireMessage(*fwm); err != nil {
+		return nil, err
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return NewReader(&buf, PreserveUnknownTags(true)).Next()
+}
+
+// imadField returns the IMAD as the 22 characters a PreviousMessageIdentifier {3500} refers to
+func (imad *InputMessageAccountabilityData) imadField() string {
+	return strings.TrimSpace(imad.InputCycleDateField() + imad.InputSourceField() + imad.InputSequenceNumberField())
+}
@@ -0,0 +1,175 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+)
+
+// readTestMessage reads the first FEDWireMessage of a file in test/testdata
+func readTestMessage(t *testing.T, name string) *FEDWireMessage {
+	t.Helper()
+	fd, err := os.Open(filepath.Join("test", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	fwm, err := NewReader(fd).Next()
+	if err != nil {
+		t.Fatalf("%s: %T: %s", name, err, err)
+	}
+	return fwm
+}
+
+// mockReversalIMAD returns the IMAD of a reversal on cycle date
+func mockReversalIMAD(cycleDate string) *InputMessageAccountabilityData {
+	imad := NewInputMessageAccountabilityData()
+	imad.InputCycleDate = cycleDate
+	imad.InputSource = "Source08"
+	imad.InputSequenceNumber = "000002"
+	return imad
+}
+
+// TestNewReversalTransfer validates a value reversal swaps the sender and receiver and refers to the original
+func TestNewReversalTransfer(t *testing.T) {
+	original := readTestMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	cycleDate := original.InputMessageAccountabilityData.InputCycleDate
+	previous := original.PreviousMessageIdentifier.String()
+
+	fwm, err := NewReversalTransfer(original, mockReversalIMAD(cycleDate))
+	if err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if fwm.TypeSubType.SubTypeCode != ReversalTransfer {
+		t.Errorf("SubTypeCode %s", fwm.TypeSubType.SubTypeCode)
+	}
+	if fwm.SenderDepositoryInstitution.SenderABANumber != original.ReceiverDepositoryInstitution.ReceiverABANumber ||
+		fwm.ReceiverDepositoryInstitution.ReceiverABANumber != original.SenderDepositoryInstitution.SenderABANumber {
+		t.Errorf("sender %v and receiver %v are not swapped", fwm.SenderDepositoryInstitution, fwm.ReceiverDepositoryInstitution)
+	}
+	want := original.InputMessageAccountabilityData.InputCycleDate + original.InputMessageAccountabilityData.InputSourceField() +
+		original.InputMessageAccountabilityData.InputSequenceNumberField()
+	if fwm.PreviousMessageIdentifier.PreviousMessageIdentifier != want {
+		t.Errorf("PreviousMessageIdentifier %q, want %q", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier, want)
+	}
+	if fwm.Amount.Amount != original.Amount.Amount || fwm.SenderReference != nil {
+		t.Errorf("unexpected Amount %v or SenderReference %v", fwm.Amount, fwm.SenderReference)
+	}
+
+	// the original is not changed
+	if original.PreviousMessageIdentifier.String() != previous || original.TypeSubType.SubTypeCode != BasicFundsTransfer {
+		t.Errorf("original changed: %v %v", original.PreviousMessageIdentifier, original.TypeSubType)
+	}
+
+	fwm, err = NewReversalTransfer(original, mockReversalIMAD("21000101"))
+	if err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if fwm.TypeSubType.SubTypeCode != ReversalPriorDayTransfer {
+		t.Errorf("SubTypeCode %s", fwm.TypeSubType.SubTypeCode)
+	}
+}
+
+// TestNewReversalRequest validates a request for reversal is sent to the receiver of the original
+func TestNewReversalRequest(t *testing.T) {
+	original := readTestMessage(t, "fedWireMessage-CustomerTransferPlus.txt")
+
+	fwm, err := NewReversalRequest(original, mockReversalIMAD("21000101"))
+	if err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if fwm.TypeSubType.SubTypeCode != RequestReversalPriorDayTransfer {
+		t.Errorf("SubTypeCode %s", fwm.TypeSubType.SubTypeCode)
+	}
+	if fwm.SenderDepositoryInstitution.SenderABANumber != original.SenderDepositoryInstitution.SenderABANumber {
+		t.Errorf("sender %v is not the original sender", fwm.SenderDepositoryInstitution)
+	}
+	if fwm.PreviousMessageIdentifier == nil {
+		t.Error("PreviousMessageIdentifier is not set")
+	}
+
+	// a CustomerTransfer (CTR) can not be a request for reversal
+	original = readTestMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	if _, err := NewReversalRequest(original, mockReversalIMAD("21000101")); err == nil {
+		t.Error("expected an error")
+	}
+
+	if _, err := NewReversalRequest(original, nil); !base.Match(err, ErrFieldRequired) {
+		t.Errorf("%T: %s", err, err)
+	}
+	if _, err := NewReversalRequest(&FEDWireMessage{}, mockReversalIMAD("21000101")); !base.Match(err, ErrFieldRequired) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestNewFEDFundsReturned validates FED funds sold are returned to their sender
+func TestNewFEDFundsReturned(t *testing.T) {
+	original := readTestMessage(t, "fedWireMessage-FEDFundsSold.txt")
+
+	fwm, err := NewFEDFundsReturned(original, mockReversalIMAD("21000101"))
+	if err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+	if fwm.BusinessFunctionCode.BusinessFunctionCode != FEDFundsReturned || fwm.TypeSubType.SubTypeCode != BasicFundsTransfer {
+		t.Errorf("unexpected BusinessFunctionCode %v or TypeSubType %v", fwm.BusinessFunctionCode, fwm.TypeSubType)
+	}
+	if fwm.ReceiverDepositoryInstitution.ReceiverABANumber != original.SenderDepositoryInstitution.SenderABANumber {
+		t.Errorf("receiver %v is not the original sender", fwm.ReceiverDepositoryInstitution)
+	}
+
+	original = readTestMessage(t, "fedWireMessage-BankTransfer.txt")
+	if _, err := NewFEDFundsReturned(original, mockReversalIMAD("21000101")); !base.Match(err, ErrBusinessFunctionCode) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestReversalUnknownTags validates a FEDWireMessage read with PreserveUnknownTags can be reversed or returned,
+// keeping its UnknownTags
+func TestReversalUnknownTags(t *testing.T) {
+	for _, name := range []string{"fedWireMessage-CustomerTransferPlus.txt", "fedWireMessage-FEDFundsSold.txt"} {
+		bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		text := strings.Replace(string(bs), "{2000}", "{3333}Unknown\n{2000}", 1)
+		original, err := NewReader(strings.NewReader(text), PreserveUnknownTags(true)).Next()
+		if err != nil {
+			t.Fatalf("%s: %T: %s", name, err, err)
+		}
+		if len(original.UnknownTags) != 1 {
+			t.Fatalf("%s: unexpected UnknownTags %v", name, original.UnknownTags)
+		}
+
+		var fwms []*FEDWireMessage
+		if original.BusinessFunctionCode.BusinessFunctionCode == FEDFundsSold {
+			fwm, err := NewFEDFundsReturned(original, mockReversalIMAD("21000101"))
+			if err != nil {
+				t.Fatalf("%s: %T: %s", name, err, err)
+			}
+			fwms = append(fwms, fwm)
+		} else {
+			fwm, err := NewReversalRequest(original, mockReversalIMAD("21000101"))
+			if err != nil {
+				t.Fatalf("%s: %T: %s", name, err, err)
+			}
+			fwms = append(fwms, fwm)
+			if fwm, err = NewReversalTransfer(original, mockReversalIMAD("21000101")); err != nil {
+				t.Fatalf("%s: %T: %s", name, err, err)
+			}
+			fwms = append(fwms, fwm)
+		}
+		for _, fwm := range fwms {
+			if len(fwm.UnknownTags) != 1 || fwm.UnknownTags[0].String() != "{3333}Unknown" {
+				t.Errorf("%s: unexpected UnknownTags %v", name, fwm.UnknownTags)
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// RoutingDirectory confirms routing numbers are active Fedwire participants. Once set on a File with
+// File.SetRoutingDirectory the routing numbers of SenderDepositoryInstitution {3100},
+// ReceiverDepositoryInstitution {3400} and each Originator, Beneficiary and FinancialInstitution identified by a
+// FEDRoutingNumber are checked against it.
+type RoutingDirectory interface {
+	// IsParticipant returns true when routingNumber is an active Fedwire participant
+	IsParticipant(routingNumber string) bool
+}
+
+// routingNumber is a routing number of a FEDWireMessage along with the name of the tag and field holding it
+type routingNumber struct {
+	tag, field, number string
+}
+
+// routingNumbers returns the routing numbers of fwm which are checked against a RoutingDirectory
+func (fwm *FEDWireMessage) routingNumbers() []routingNumber {
+	var numbers []routingNumber
+	if sdi := fwm.SenderDepositoryInstitution; sdi != nil {
+		numbers = append(numbers, routingNumber{"SenderDepositoryInstitution", "SenderABANumber", sdi.SenderABANumber})
+	}
+	if rdi := fwm.ReceiverDepositoryInstitution; rdi != nil {
+		numbers = append(numbers, routingNumber{"ReceiverDepositoryInstitution", "ReceiverABANumber", rdi.ReceiverABANumber})
+	}
+	identified := func(tag, code, identifier string) {
+		if code == FEDRoutingNumber {
+			numbers = append(numbers, routingNumber{tag, "Identifier", identifier})
+		}
+	}
+	if bifi := fwm.BeneficiaryIntermediaryFI; bifi != nil {
+		identified("BeneficiaryIntermediaryFI", bifi.FinancialInstitution.IdentificationCode, bifi.FinancialInstitution.Identifier)
+	}
+	if bfi := fwm.BeneficiaryFI; bfi != nil {
+		identified("BeneficiaryFI", bfi.FinancialInstitution.IdentificationCode, bfi.FinancialInstitution.Identifier)
+	}
+	if ben := fwm.Beneficiary; ben != nil {
+		identified("Beneficiary", ben.Personal.IdentificationCode, ben.Personal.Identifier)
+	}
+	if o := fwm.Originator; o != nil {
+		identified("Originator", o.Personal.IdentificationCode, o.Personal.Identifier)
+	}
+	if ofi := fwm.OriginatorFI; ofi != nil {
+		identified("OriginatorFI", ofi.FinancialInstitution.IdentificationCode, ofi.FinancialInstitution.Identifier)
+	}
+	if ifi := fwm.InstructingFI; ifi != nil {
+		identified("InstructingFI", ifi.FinancialInstitution.IdentificationCode, ifi.FinancialInstitution.Identifier)
+	}
+	return numbers
+}
+
+// isParticipant validates each routing number of the FEDWireMessage is listed by directory as an active Fedwire
+// participant
+func (fwm *FEDWireMessage) isParticipant(directory RoutingDirectory) error {
+	var errs ruleErrors
+	for _, rn := range fwm.routingNumbers() {
+		if !directory.IsParticipant(rn.number) {
+			errs.add(fieldError(rn.tag+"."+rn.field, ErrNotFedwireParticipant, rn.number))
+		}
+	}
+	return errs.err()
+}
+
+// fedwireDirectoryLineLength is the length of a line of the Fedwire participant directory
+const fedwireDirectoryLineLength = 101
+
+// FedwireParticipant is a participant of the Fedwire participant directory
+type FedwireParticipant struct {
+	// RoutingNumber is the nine digit ABA routing number
+	RoutingNumber string `json:"routingNumber"`
+	// TelegraphicName is the short name of the participant
+	TelegraphicName string `json:"telegraphicName"`
+	// CustomerName is the name of the participant
+	CustomerName string `json:"customerName"`
+	// State is the two letter state of the participant
+	State string `json:"state"`
+	// City is the city of the participant
+	City string `json:"city"`
+	// FundsTransferStatus is true when the participant is eligible to receive funds transfers
+	FundsTransferStatus bool `json:"fundsTransferStatus"`
+	// FundsSettlementOnlyStatus is true when the participant is a settlement only participant
+	FundsSettlementOnlyStatus bool `json:"fundsSettlementOnlyStatus"`
+	// BookEntrySecuritiesTransferStatus is true when the participant is eligible for book-entry securities
+	// transfers
+	BookEntrySecuritiesTransferStatus bool `json:"bookEntrySecuritiesTransferStatus"`
+	// Date is the date of last revision CCYYMMDD
+	Date string `json:"date"`
+}
+
+// FedwireDirectory is a RoutingDirectory of the participants of the Fedwire Funds Service
+type FedwireDirectory struct {
+	participants map[string]*FedwireParticipant
+}
+
+// ReadFedwireDirectory reads the fixed width Fedwire participant directory (fpddir.txt) published by the
+// Federal Reserve, one 101 character participant per line. Blank lines are skipped.
+func ReadFedwireDirectory(r io.Reader) (*FedwireDirectory, error) {
+	directory := &FedwireDirectory{participants: make(map[string]*FedwireParticipant)}
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		p, err := parseFedwireParticipant(line)
+		if err != nil {
+			return nil, &base.ParseError{Line: lineNumber, Err: err}
+		}
+		directory.participants[p.RoutingNumber] = p
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return directory, nil
+}
+
+// parseFedwireParticipant parses a line of the Fedwire participant directory
+func parseFedwireParticipant(line string) (*FedwireParticipant, error) {
+	if len(line) != fedwireDirectoryLineLength {
+		return nil, NewTagWrongLengthErr(fedwireDirectoryLineLength, len(line))
+	}
+	p := &FedwireParticipant{
+		RoutingNumber:                     strings.TrimSpace(line[:9]),
+		TelegraphicName:                   strings.TrimSpace(line[9:27]),
+		CustomerName:                      strings.TrimSpace(line[27:63]),
+		State:                             strings.TrimSpace(line[63:65]),
+		City:                              strings.TrimSpace(line[65:90]),
+		FundsTransferStatus:               line[90] == 'Y',
+		FundsSettlementOnlyStatus:         line[91] == 'S',
+		BookEntrySecuritiesTransferStatus: line[92] == 'Y',
+		Date:                              strings.TrimSpace(line[93:101]),
+	}
+	if err := (&validator{}).isNumeric(p.RoutingNumber); err != nil || len(p.RoutingNumber) != 9 {
+		return nil, fieldError("RoutingNumber", ErrRoutingNumber, p.RoutingNumber)
+	}
+	return p, nil
+}
+
+// Participant returns the participant with routingNumber, or nil when there is no such participant
+func (d *FedwireDirectory) Participant(routingNumber string) *FedwireParticipant {
+	return d.participants[routingNumber]
+}
+
+// IsParticipant returns true when routingNumber is eligible to receive funds transfers
+func (d *FedwireDirectory) IsParticipant(routingNumber string) bool {
+	p := d.participants[routingNumber]
+	return p != nil && p.FundsTransferStatus
+}
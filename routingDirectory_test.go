@@ -0,0 +1,97 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+)
+
+// mockFedwireDirectoryLine returns a line of the Fedwire participant directory
+func mockFedwireDirectoryLine(routingNumber, name string, fundsTransfer string) string {
+	return fmt.Sprintf("%-9s%-18s%-36s%-2s%-25s%-1s%-1s%-1s%-8s",
+		routingNumber, name, name+" NA", "CA", "SAN FRANCISCO", fundsTransfer, "", "Y", "20200701")
+}
+
+// TestReadFedwireDirectory validates the participants of a Fedwire participant directory
+func TestReadFedwireDirectory(t *testing.T) {
+	directory, err := ReadFedwireDirectory(strings.NewReader(strings.Join([]string{
+		mockFedwireDirectoryLine("121042882", "WELLS FARGO", "Y"),
+		"",
+		mockFedwireDirectoryLine("231380104", "CITIBANK", "N"),
+	}, "\r\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := directory.Participant("121042882")
+	if p == nil || p.TelegraphicName != "WELLS FARGO" || p.City != "SAN FRANCISCO" || !p.BookEntrySecuritiesTransferStatus || p.Date != "20200701" {
+		t.Errorf("unexpected participant %#v", p)
+	}
+	if !directory.IsParticipant("121042882") {
+		t.Error("121042882 is not a participant")
+	}
+	if directory.IsParticipant("231380104") || directory.IsParticipant("011000015") {
+		t.Error("unexpected participant")
+	}
+
+	if _, err := ReadFedwireDirectory(strings.NewReader("121042882WELLS FARGO")); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := ReadFedwireDirectory(strings.NewReader(mockFedwireDirectoryLine("12104288A", "WELLS FARGO", "Y"))); !base.Match(err, ErrRoutingNumber) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestRoutingDirectory validates the routing numbers of a File are checked against its RoutingDirectory once it
+// is set
+func TestRoutingDirectory(t *testing.T) {
+	directory, err := ReadFedwireDirectory(strings.NewReader(mockFedwireDirectoryLine("121042882", "WELLS FARGO", "Y")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	file, err := NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fwm := file.FEDWireMessage()
+	fwm.BeneficiaryFI.FinancialInstitution.IdentificationCode = FEDRoutingNumber
+	fwm.BeneficiaryFI.FinancialInstitution.Identifier = "121042882"
+	if err := file.Validate(); err != nil {
+		t.Fatalf("%T: %s", err, err)
+	}
+
+	file.SetRoutingDirectory(directory)
+	if err := file.Validate(); !base.Match(err, ErrNotFedwireParticipant) {
+		t.Errorf("%T: %s", err, err)
+	}
+	errs := file.ValidateAll()
+	if len(errs) != 1 || errs[0].Tag != TagReceiverDepositoryInstitution || errs[0].Field != "ReceiverABANumber" || errs[0].Value != "231380104" {
+		t.Errorf("unexpected errors: %#v", errs)
+	}
+	// the tags themselves only check the check digit
+	if err := fwm.ReceiverDepositoryInstitution.Validate(); err != nil {
+		t.Errorf("%T: %s", err, err)
+	}
+
+	fwm.BeneficiaryFI.FinancialInstitution.Identifier = "231380104"
+	if errs := file.ValidateAll(); len(errs) != 2 || errs[1].Tag != TagBeneficiaryFI || errs[1].Field != "Identifier" {
+		t.Errorf("unexpected errors: %#v", errs)
+	}
+
+	file.SetRoutingDirectory(nil)
+	if errs := file.ValidateAll(); len(errs) != 0 {
+		t.Errorf("unexpected errors: %#v", errs)
+	}
+}
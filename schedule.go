@@ -0,0 +1,160 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"time"
+)
+
+// Schedule is the operating schedule of the Fedwire Funds Service: the business days which are cycle dates and
+// the window of each cycle date in which a BusinessFunctionCode is accepted. The Fedwire Funds Service rejects
+// a FEDWireMessage received after its cutoff with a Cutoff Hour Error (W) ErrorWire.
+type Schedule struct {
+	// Location is the time zone of the schedule, Eastern Time
+	Location *time.Location
+	// Open is when the Fedwire Funds Service opens for a cycle date, relative to midnight of the cycle date
+	Open time.Duration
+	// Close is the cutoff of a BusinessFunctionCode without a Cutoffs entry, relative to midnight of the cycle date
+	Close time.Duration
+	// Cutoffs is the cutoff of a BusinessFunctionCode, relative to midnight of the cycle date
+	Cutoffs map[string]time.Duration
+	// Closed are dates, other than Saturdays, Sundays and Federal Reserve holidays, which are not business days
+	Closed []time.Time
+}
+
+// NewSchedule returns the Schedule of the Fedwire Funds Service, which opens at 9:00 p.m. ET on the preceding
+// calendar day and closes at 7:00 p.m. ET, with a 6:00 p.m. ET cutoff for customer transfers.
+func NewSchedule() *Schedule {
+	return &Schedule{
+		Location: easternTime(),
+		Open:     -3 * time.Hour,
+		Close:    19 * time.Hour,
+		Cutoffs: map[string]time.Duration{
+			CustomerTransfer:     18 * time.Hour,
+			CustomerTransferPlus: 18 * time.Hour,
+		},
+	}
+}
+
+// IsBusinessDay returns true when date is a Fedwire business day, a weekday which is not a Federal Reserve
+// holiday or closed
+func (s *Schedule) IsBusinessDay(date time.Time) bool {
+	switch date.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	y, m, d := date.Date()
+	for _, holiday := range append(FedHolidays(y), s.Closed...) {
+		if hy, hm, hd := holiday.Date(); hy == y && hm == m && hd == d {
+			return false
+		}
+	}
+	return true
+}
+
+// NextBusinessDay returns the first business day after date
+func (s *Schedule) NextBusinessDay(date time.Time) time.Time {
+	date = s.midnight(date).AddDate(0, 0, 1)
+	for !s.IsBusinessDay(date) {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// CycleDate returns the cycle date, at midnight in Location, which is open or next opens at t
+func (s *Schedule) CycleDate(t time.Time) time.Time {
+	date := s.midnight(t.In(s.Location).Add(-s.Open))
+	if !s.IsBusinessDay(date) {
+		date = s.NextBusinessDay(date)
+	}
+	return date
+}
+
+// Cutoff returns the cutoff of businessFunctionCode on cycleDate
+func (s *Schedule) Cutoff(cycleDate time.Time, businessFunctionCode string) time.Time {
+	cutoff, ok := s.Cutoffs[businessFunctionCode]
+	if !ok {
+		cutoff = s.Close
+	}
+	return s.midnight(cycleDate).Add(cutoff)
+}
+
+// Check validates the InputCycleDate of fwm is a business day which is open at t, and t is before the cutoff
+// of the BusinessFunctionCode of fwm. Every problem found is returned.
+func (s *Schedule) Check(fwm *FEDWireMessage, t time.Time) error {
+	if fwm.InputMessageAccountabilityData == nil {
+		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	cycleDate, err := time.ParseInLocation("20060102", fwm.InputMessageAccountabilityData.InputCycleDate, s.Location)
+	if err != nil {
+		return fieldError("InputMessageAccountabilityData.InputCycleDate", ErrValidDate, fwm.InputMessageAccountabilityData.InputCycleDate)
+	}
+
+	var errs ruleErrors
+	if !s.IsBusinessDay(cycleDate) {
+		errs.add(fieldError("InputMessageAccountabilityData.InputCycleDate", ErrBusinessDay, fwm.InputMessageAccountabilityData.InputCycleDate))
+	}
+	if t.Before(cycleDate.Add(s.Open)) {
+		errs.add(fieldError("InputMessageAccountabilityData.InputCycleDate", ErrCycleDateNotOpen, fwm.InputMessageAccountabilityData.InputCycleDate))
+	}
+	if fwm.BusinessFunctionCode != nil {
+		code := fwm.BusinessFunctionCode.BusinessFunctionCode
+		if !t.Before(s.Cutoff(cycleDate, code)) {
+			errs.add(fieldError("BusinessFunctionCode.BusinessFunctionCode", ErrCutoffHour, code))
+		}
+	}
+	return errs.err()
+}
+
+// midnight returns midnight of the date of t in Location
+func (s *Schedule) midnight(t time.Time) time.Time {
+	y, m, d := t.In(s.Location).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, s.Location)
+}
+
+// FedHolidays returns the dates, in UTC, the Federal Reserve Banks are closed for a holiday in year. A holiday
+// which falls on a Sunday is observed the following Monday, the Federal Reserve Banks are open the day before a
+// holiday which falls on a Saturday.
+func FedHolidays(year int) []time.Time {
+	observed := func(month time.Month, day int) time.Time {
+		date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		if date.Weekday() == time.Sunday {
+			date = date.AddDate(0, 0, 1)
+		}
+		return date
+	}
+	// nth returns the nth weekday of month, the last when n is -1
+	nth := func(month time.Month, weekday time.Weekday, n int) time.Time {
+		if n < 0 {
+			date := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+			return date.AddDate(0, 0, -((int(date.Weekday()) - int(weekday) + 7) % 7))
+		}
+		date := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+		return date.AddDate(0, 0, (int(weekday)-int(date.Weekday())+7)%7+7*(n-1))
+	}
+
+	holidays := []time.Time{
+		observed(time.January, 1),            // New Year's Day
+		nth(time.January, time.Monday, 3),    // Birthday of Martin Luther King, Jr.
+		nth(time.February, time.Monday, 3),   // Washington's Birthday
+		nth(time.May, time.Monday, -1),       // Memorial Day
+		observed(time.July, 4),               // Independence Day
+		nth(time.September, time.Monday, 1),  // Labor Day
+		nth(time.October, time.Monday, 2),    // Columbus Day
+		observed(time.November, 11),          // Veterans Day
+		nth(time.November, time.Thursday, 4), // Thanksgiving Day
+		observed(time.December, 25),          // Christmas Day
+	}
+	if year >= 2022 {
+		holidays = append(holidays, observed(time.June, 19)) // Juneteenth National Independence Day
+	}
+	var closed []time.Time
+	for _, holiday := range holidays {
+		if holiday.Weekday() != time.Saturday {
+			closed = append(closed, holiday)
+		}
+	}
+	return closed
+}
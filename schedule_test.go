@@ -0,0 +1,124 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moov-io/base"
+)
+
+// TestFedHolidays validates the observed Federal Reserve holidays
+func TestFedHolidays(t *testing.T) {
+	var got []string
+	for _, holiday := range FedHolidays(2022) {
+		got = append(got, holiday.Format("0102"))
+	}
+	// New Year's Day falls on a Saturday, Juneteenth and Christmas Day on a Sunday
+	want := []string{"0117", "0221", "0530", "0704", "0905", "1010", "1111", "1124", "1226", "0620"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+}
+
+// TestScheduleIsBusinessDay validates weekends, holidays and closed dates are not business days
+func TestScheduleIsBusinessDay(t *testing.T) {
+	s := NewSchedule()
+	s.Closed = []time.Time{time.Date(2019, time.April, 11, 0, 0, 0, 0, s.Location)}
+	for date, want := range map[string]bool{
+		"20190410": true,
+		"20190411": false,
+		"20190413": false,
+		"20190414": false,
+		"20191128": false,
+		"20200703": true,
+		"20200704": false,
+		"20211231": true,
+	} {
+		d, _ := time.ParseInLocation("20060102", date, s.Location)
+		if got := s.IsBusinessDay(d); got != want {
+			t.Errorf("%s: got %v", date, got)
+		}
+	}
+}
+
+// TestScheduleCycleDate validates the cycle date opens at 9:00 p.m. ET on the preceding calendar day
+func TestScheduleCycleDate(t *testing.T) {
+	s := NewSchedule()
+	for at, want := range map[time.Time]string{
+		time.Date(2019, time.April, 9, 20, 59, 0, 0, s.Location):    "20190409",
+		time.Date(2019, time.April, 9, 21, 0, 0, 0, s.Location):     "20190410",
+		time.Date(2019, time.November, 27, 22, 0, 0, 0, s.Location): "20191129",
+		time.Date(2020, time.July, 3, 22, 0, 0, 0, s.Location):      "20200706",
+		time.Date(2020, time.July, 4, 12, 0, 0, 0, time.UTC):        "20200706",
+	} {
+		if got := s.CycleDate(at).Format("20060102"); got != want {
+			t.Errorf("%s: got %s, want %s", at, got, want)
+		}
+	}
+}
+
+// TestScheduleCheck validates the cycle date and cutoff of a FEDWireMessage
+func TestScheduleCheck(t *testing.T) {
+	s := NewSchedule()
+	fwm := mockCustomerTransferData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20190410"
+
+	if err := s.Check(&fwm, time.Date(2019, time.April, 10, 17, 59, 0, 0, s.Location)); err != nil {
+		t.Errorf("%T: %s", err, err)
+	}
+	if err := s.Check(&fwm, time.Date(2019, time.April, 10, 18, 0, 0, 0, s.Location)); !base.Match(err, ErrCutoffHour) {
+		t.Errorf("%T: %s", err, err)
+	}
+	if err := s.Check(&fwm, time.Date(2019, time.April, 9, 20, 0, 0, 0, s.Location)); !base.Match(err, ErrCycleDateNotOpen) {
+		t.Errorf("%T: %s", err, err)
+	}
+
+	// bank transfers are accepted until the Fedwire Funds Service closes
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer
+	if err := s.Check(&fwm, time.Date(2019, time.April, 10, 18, 30, 0, 0, s.Location)); err != nil {
+		t.Errorf("%T: %s", err, err)
+	}
+
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20200704"
+	errs := ValidationErrors{}
+	errs.add(0, "", s.Check(&fwm, time.Date(2020, time.July, 4, 12, 0, 0, 0, s.Location)))
+	if len(errs) != 1 || errs[0].Tag != TagInputMessageAccountabilityData || errs[0].Rule != ErrBusinessDay.Error() {
+		t.Errorf("unexpected errors %#v", errs)
+	}
+
+	fwm.InputMessageAccountabilityData.InputCycleDate = "2020070Z"
+	if err := s.Check(&fwm, time.Now()); !base.Match(err, ErrValidDate) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
+
+// TestEasternTimeData validates the embedded Eastern Time follows daylight saving time
+func TestEasternTimeData(t *testing.T) {
+	location, err := time.LoadLocationFromTZData(easternTimeZone, []byte(easternTimeData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for at, want := range map[time.Time]string{
+		time.Date(2020, time.January, 15, 12, 0, 0, 0, time.UTC): "EST-0500",
+		time.Date(2020, time.July, 15, 12, 0, 0, 0, time.UTC):    "EDT-0400",
+		time.Date(2020, time.March, 8, 7, 0, 0, 0, time.UTC):     "EDT-0400",
+		time.Date(2020, time.March, 8, 6, 59, 0, 0, time.UTC):    "EST-0500",
+		time.Date(2036, time.November, 2, 6, 0, 0, 0, time.UTC):  "EST-0500",
+	} {
+		if got := at.In(location).Format("MST-0700"); got != want {
+			t.Errorf("%s: got %s, want %s", at, got, want)
+		}
+	}
+	if easternTime() == nil {
+		t.Error("no Eastern Time")
+	}
+}
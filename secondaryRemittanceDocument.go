@@ -36,6 +36,11 @@ func NewSecondaryRemittanceDocument() *SecondaryRemittanceDocument {
 	return srd
 }
 
+// setTag sets the tag of SecondaryRemittanceDocument, which JSON does not hold
+func (srd *SecondaryRemittanceDocument) setTag() {
+	srd.tag = TagSecondaryRemittanceDocument
+}
+
 // Parse takes the input string and parses the SecondaryRemittanceDocument values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -32,6 +32,11 @@ func NewSenderDepositoryInstitution() *SenderDepositoryInstitution {
 	return sdi
 }
 
+// setTag sets the tag of SenderDepositoryInstitution, which JSON does not hold
+func (sdi *SenderDepositoryInstitution) setTag() {
+	sdi.tag = TagSenderDepositoryInstitution
+}
+
 // Parse takes the input string and parses the SenderDepositoryInstitution values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -66,7 +71,7 @@ func (sdi *SenderDepositoryInstitution) Validate() error {
 	if sdi.tag != TagSenderDepositoryInstitution {
 		return fieldError("tag", ErrValidTagForType, sdi.tag)
 	}
-	if err := sdi.isNumeric(sdi.SenderABANumber); err != nil {
+	if err := sdi.isRoutingNumber(sdi.SenderABANumber); err != nil {
 		return fieldError("SenderABANumber", err, sdi.SenderABANumber)
 	}
 	if err := sdi.isAlphanumeric(sdi.SenderShortName); err != nil {
@@ -114,3 +114,12 @@ func TestSenderDepositoryInstitutionTagError(t *testing.T) {
 		}
 	}
 }
+
+// TestSenderABANumberCheckDigit validates SenderDepositoryInstitution SenderABANumber has a valid check digit
+func TestSenderABANumberCheckDigit(t *testing.T) {
+	sdi := mockSenderDepositoryInstitution()
+	sdi.SenderABANumber = "121042883"
+	if err := sdi.Validate(); !base.Match(err, ErrRoutingNumber) {
+		t.Errorf("%T: %s", err, err)
+	}
+}
@@ -30,6 +30,11 @@ func NewSenderReference() *SenderReference {
 	return sr
 }
 
+// setTag sets the tag of SenderReference, which JSON does not hold
+func (sr *SenderReference) setTag() {
+	sr.tag = TagSenderReference
+}
+
 // Parse takes the input string and parses the SenderReference values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
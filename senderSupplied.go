@@ -39,6 +39,11 @@ func NewSenderSupplied() *SenderSupplied {
 	return ss
 }
 
+// setTag sets the tag of SenderSupplied, which JSON does not hold
+func (ss *SenderSupplied) setTag() {
+	ss.tag = TagSenderSupplied
+}
+
 // Parse takes the input string and parses the SenderSupplied values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
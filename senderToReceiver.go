@@ -30,6 +30,11 @@ func NewSenderToReceiver() *SenderToReceiver {
 	return str
 }
 
+// setTag sets the tag of SenderToReceiver, which JSON does not hold
+func (str *SenderToReceiver) setTag() {
+	str.tag = TagSenderToReceiver
+}
+
 // Parse takes the input string and parses the SenderToReceiver values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
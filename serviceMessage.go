@@ -52,6 +52,11 @@ func NewServiceMessage() *ServiceMessage {
 	return sm
 }
 
+// setTag sets the tag of ServiceMessage, which JSON does not hold
+func (sm *ServiceMessage) setTag() {
+	sm.tag = TagServiceMessage
+}
+
 // Parse takes the input string and parses the ServiceMessage values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
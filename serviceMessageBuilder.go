@@ -0,0 +1,46 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// ServiceMessageBuilder builds a service message (SVC) FEDWireMessage and only sets the tags it may have. The
+// TypeSubType defaults to a service message (1090). The Amount of a service message defaults to zero.
+type ServiceMessageBuilder struct {
+	messageBuilder
+}
+
+// NewServiceMessageBuilder returns a new ServiceMessageBuilder
+func NewServiceMessageBuilder() *ServiceMessageBuilder {
+	b := &ServiceMessageBuilder{newMessageBuilder(BFCServiceMessage, builderTypeSubType(ServiceMessageFunds))}
+	b.amount("000000000000")
+	return b
+}
+
+// ServiceMessageTypeSubType is a TypeSubType {1510} a service message may have
+type ServiceMessageTypeSubType builderTypeSubType
+
+var (
+	// ServiceMessageFundsRequestReversal is a request for reversal of a funds transfer (1001)
+	ServiceMessageFundsRequestReversal = ServiceMessageTypeSubType{FundsTransfer, RequestReversal}
+	// ServiceMessageFundsRequestReversalPriorDay is a request for reversal of a prior day funds transfer (1007)
+	ServiceMessageFundsRequestReversalPriorDay = ServiceMessageTypeSubType{FundsTransfer, RequestReversalPriorDayTransfer}
+	// ServiceMessageFundsRefusalRequestCredit is a refusal of a request for credit by a funds transfer (1033)
+	ServiceMessageFundsRefusalRequestCredit = ServiceMessageTypeSubType{FundsTransfer, RefusalRequestCredit}
+	// ServiceMessageFunds is a service message about a funds transfer (1090)
+	ServiceMessageFunds = ServiceMessageTypeSubType{FundsTransfer, SSIServiceMessage}
+	// ServiceMessageForeignRequestReversal is a request for reversal of a foreign transfer (1501)
+	ServiceMessageForeignRequestReversal = ServiceMessageTypeSubType{ForeignTransfer, RequestReversal}
+	// ServiceMessageForeignRequestReversalPriorDay is a request for reversal of a prior day foreign transfer (1507)
+	ServiceMessageForeignRequestReversalPriorDay = ServiceMessageTypeSubType{ForeignTransfer, RequestReversalPriorDayTransfer}
+	// ServiceMessageForeign is a service message about a foreign transfer (1590)
+	ServiceMessageForeign = ServiceMessageTypeSubType{ForeignTransfer, SSIServiceMessage}
+	// ServiceMessageSettlementRequestReversal is a request for reversal of a settlement transfer (1601)
+	ServiceMessageSettlementRequestReversal = ServiceMessageTypeSubType{SettlementTransfer, RequestReversal}
+	// ServiceMessageSettlementRequestReversalPriorDay is a request for reversal of a prior day settlement transfer (1607)
+	ServiceMessageSettlementRequestReversalPriorDay = ServiceMessageTypeSubType{SettlementTransfer, RequestReversalPriorDayTransfer}
+	// ServiceMessageSettlementRefusalRequestCredit is a refusal of a request for credit by a settlement transfer (1633)
+	ServiceMessageSettlementRefusalRequestCredit = ServiceMessageTypeSubType{SettlementTransfer, RefusalRequestCredit}
+	// ServiceMessageSettlement is a service message about a settlement transfer (1690)
+	ServiceMessageSettlement = ServiceMessageTypeSubType{SettlementTransfer, SSIServiceMessage}
+)
@@ -0,0 +1,182 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"reflect"
+)
+
+// messageTag describes a tag of a FEDWireMessage
+type messageTag struct {
+	// Tag is the tag, e.g. {1500}
+	Tag string
+	// Name is the name of the FEDWireMessage field which holds the tag
+	Name string
+}
+
+// messageTags lists the tags of a FEDWireMessage in the order they appear in a FEDWireMessage
+var messageTags = []messageTag{
+	{TagMessageDisposition, "MessageDisposition"},
+	{TagReceiptTimeStamp, "ReceiptTimeStamp"},
+	{TagOutputMessageAccountabilityData, "OutputMessageAccountabilityData"},
+	{TagErrorWire, "ErrorWire"},
+	{TagSenderSupplied, "SenderSupplied"},
+	{TagTypeSubType, "TypeSubType"},
+	{TagInputMessageAccountabilityData, "InputMessageAccountabilityData"},
+	{TagAmount, "Amount"},
+	{TagSenderDepositoryInstitution, "SenderDepositoryInstitution"},
+	{TagReceiverDepositoryInstitution, "ReceiverDepositoryInstitution"},
+	{TagBusinessFunctionCode, "BusinessFunctionCode"},
+	{TagSenderReference, "SenderReference"},
+	{TagPreviousMessageIdentifier, "PreviousMessageIdentifier"},
+	{TagLocalInstrument, "LocalInstrument"},
+	{TagPaymentNotification, "PaymentNotification"},
+	{TagCharges, "Charges"},
+	{TagInstructedAmount, "InstructedAmount"},
+	{TagExchangeRate, "ExchangeRate"},
+	{TagBeneficiaryIntermediaryFI, "BeneficiaryIntermediaryFI"},
+	{TagBeneficiaryFI, "BeneficiaryFI"},
+	{TagBeneficiary, "Beneficiary"},
+	{TagBeneficiaryReference, "BeneficiaryReference"},
+	{TagAccountDebitedDrawdown, "AccountDebitedDrawdown"},
+	{TagOriginator, "Originator"},
+	{TagOriginatorOptionF, "OriginatorOptionF"},
+	{TagOriginatorFI, "OriginatorFI"},
+	{TagInstructingFI, "InstructingFI"},
+	{TagAccountCreditedDrawdown, "AccountCreditedDrawdown"},
+	{TagOriginatorToBeneficiary, "OriginatorToBeneficiary"},
+	{TagFIReceiverFI, "FIReceiverFI"},
+	{TagFIDrawdownDebitAccountAdvice, "FIDrawdownDebitAccountAdvice"},
+	{TagFIIntermediaryFI, "FIIntermediaryFI"},
+	{TagFIIntermediaryFIAdvice, "FIIntermediaryFIAdvice"},
+	{TagFIBeneficiaryFI, "FIBeneficiaryFI"},
+	{TagFIBeneficiaryFIAdvice, "FIBeneficiaryFIAdvice"},
+	{TagFIBeneficiary, "FIBeneficiary"},
+	{TagFIBeneficiaryAdvice, "FIBeneficiaryAdvice"},
+	{TagFIPaymentMethodToBeneficiary, "FIPaymentMethodToBeneficiary"},
+	{TagFIAdditionalFIToFI, "FIAdditionalFIToFI"},
+	{TagCurrencyInstructedAmount, "CurrencyInstructedAmount"},
+	{TagOrderingCustomer, "OrderingCustomer"},
+	{TagOrderingInstitution, "OrderingInstitution"},
+	{TagIntermediaryInstitution, "IntermediaryInstitution"},
+	{TagInstitutionAccount, "InstitutionAccount"},
+	{TagBeneficiaryCustomer, "BeneficiaryCustomer"},
+	{TagRemittance, "Remittance"},
+	{TagSenderToReceiver, "SenderToReceiver"},
+	{TagUnstructuredAddenda, "UnstructuredAddenda"},
+	{TagRelatedRemittance, "RelatedRemittance"},
+	{TagRemittanceOriginator, "RemittanceOriginator"},
+	{TagRemittanceBeneficiary, "RemittanceBeneficiary"},
+	{TagPrimaryRemittanceDocument, "PrimaryRemittanceDocument"},
+	{TagActualAmountPaid, "ActualAmountPaid"},
+	{TagGrossAmountRemittanceDocument, "GrossAmountRemittanceDocument"},
+	{TagAmountNegotiatedDiscount, "AmountNegotiatedDiscount"},
+	{TagAdjustment, "Adjustment"},
+	{TagDateRemittanceDocument, "DateRemittanceDocument"},
+	{TagSecondaryRemittanceDocument, "SecondaryRemittanceDocument"},
+	{TagRemittanceFreeText, "RemittanceFreeText"},
+	{TagServiceMessage, "ServiceMessage"},
+}
+
+// messageTagIndexes is the index in messageTags of each tag
+var messageTagIndexes = func() map[string]int {
+	indexes := make(map[string]int, len(messageTags))
+	for i := range messageTags {
+		indexes[messageTags[i].Tag] = i
+	}
+	return indexes
+}()
+
+// tagIndex returns the index of tag in messageTags, or -1 when tag is not a known tag.
+func tagIndex(tag string) int {
+	if i, ok := messageTagIndexes[tag]; ok {
+		return i
+	}
+	return -1
+}
+
+// tagForName returns the tag held by the FEDWireMessage field name, or an empty string when name
+// is not the name of a tag.
+func tagForName(name string) string {
+	for i := range messageTags {
+		if messageTags[i].Name == name {
+			return messageTags[i].Tag
+		}
+	}
+	return ""
+}
+
+// nameForTag returns the name of the FEDWireMessage field which holds tag, or an empty string when
+// tag is not a known tag.
+func nameForTag(tag string) string {
+	for i := range messageTags {
+		if messageTags[i].Tag == tag {
+			return messageTags[i].Name
+		}
+	}
+	return ""
+}
+
+// TagName returns the name of the FEDWireMessage field which holds tag, such as SenderSupplied for {1500}, or an
+// empty string when tag is not a known tag.
+func TagName(tag string) string {
+	return nameForTag(tag)
+}
+
+// tagValue returns the value of the FEDWireMessage field which holds tag, or nil when the tag is not set.
+func (fwm *FEDWireMessage) tagValue(tag string) interface{} {
+	name := nameForTag(tag)
+	if name == "" {
+		return nil
+	}
+	v := reflect.ValueOf(fwm).Elem().FieldByName(name)
+	if !v.IsValid() || v.IsNil() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// setTags sets the tag of each tag of the FEDWireMessage, which JSON does not hold. Only the tag is set, the values
+// read are left as they are for Validate to check.
+func (fwm *FEDWireMessage) setTags() {
+	for i := range messageTags {
+		if t, ok := fwm.tagValue(messageTags[i].Tag).(interface{ setTag() }); ok {
+			t.setTag()
+		}
+	}
+}
+
+// fieldLengthError returns an error for the first field of the tag v, held by the FEDWireMessage field name, whose
+// value is longer than the width it is written with, which would otherwise be cut short. The width of a field is
+// that of the <Field>Field method of v, fields without one are not checked.
+func fieldLengthError(name string, v interface{}) error {
+	tag := reflect.ValueOf(v)
+	return structFieldLengthError(name, tag, tag.Elem())
+}
+
+func structFieldLengthError(name string, tag, v reflect.Value) error {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		switch value := v.Field(i); value.Kind() {
+		case reflect.Struct:
+			if err := structFieldLengthError(name, tag, value); err != nil {
+				return err
+			}
+		case reflect.String:
+			m := tag.MethodByName(field.Name + "Field")
+			if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 || m.Type().Out(0).Kind() != reflect.String {
+				continue
+			}
+			width := len(m.Call(nil)[0].String())
+			if n := len(value.String()); n > width {
+				return fieldError(name+"."+field.Name, NewFieldTooLongErr(width, n), value.String())
+			}
+		}
+	}
+	return nil
+}
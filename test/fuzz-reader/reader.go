@@ -25,7 +25,10 @@ func Fuzz(data []byte) int {
 	if f.ID != "" {
 		return 1
 	}
-	return checkSenderSupplied(f.FEDWireMessage.SenderSupplied)
+	if fwm := f.FEDWireMessage(); fwm != nil {
+		return checkSenderSupplied(fwm.SenderSupplied)
+	}
+	return -1
 }
 
 func checkSenderSupplied(ss *wire.SenderSupplied) int {
@@ -32,6 +32,11 @@ func NewTypeSubType() *TypeSubType {
 	return tst
 }
 
+// setTag sets the tag of TypeSubType, which JSON does not hold
+func (tst *TypeSubType) setTag() {
+	tst.tag = TagTypeSubType
+}
+
 // Parse takes the input string and parses the TypeSubType values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
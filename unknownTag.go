@@ -0,0 +1,65 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"sort"
+	"strings"
+)
+
+// UnknownTag is a tag the Reader does not recognise, such as a new or proprietary tag of a counterparty, kept
+// verbatim when the Reader is created with PreserveUnknownTags so a FEDWireMessage can be passed through.
+type UnknownTag struct {
+	// Tag is the tag, such as {7777}
+	Tag string `json:"tag"`
+	// Value is the text following the tag, as read
+	Value string `json:"value"`
+	// Position is the number of tags preceding the tag in the FEDWireMessage, including the tags appended by the
+	// Fedwire Funds Service, so the Writer re-emits the tag in place.
+	Position int `json:"position"`
+}
+
+// String writes UnknownTag
+func (ut *UnknownTag) String() string {
+	return ut.Tag + ut.Value
+}
+
+// Validate performs checks on UnknownTag, which must not be a tag the Reader recognises or span lines.
+func (ut *UnknownTag) Validate() error {
+	if !isTag(ut.Tag) || tagIndex(ut.Tag) >= 0 {
+		return fieldError("Tag", NewErrInvalidTag(ut.Tag), ut.Tag)
+	}
+	if strings.ContainsAny(ut.Value, "\r\n") {
+		return fieldError("Value", ErrNonAlphanumeric, ut.Value)
+	}
+	return nil
+}
+
+// isTag returns true when s has the form of a tag, four characters within braces
+func isTag(s string) bool {
+	if len(s) != 6 || s[0] != '{' || s[5] != '}' {
+		return false
+	}
+	return !strings.ContainsAny(s[1:5], "{}\r\n")
+}
+
+// isUnknownTagsValid validates each UnknownTag of the FEDWireMessage
+func (fwm *FEDWireMessage) isUnknownTagsValid() error {
+	var errs ruleErrors
+	for i := range fwm.UnknownTags {
+		errs.add(fwm.UnknownTags[i].Validate())
+	}
+	return errs.err()
+}
+
+// sortedUnknownTags returns the UnknownTags of fwm ordered by Position
+func sortedUnknownTags(fwm FEDWireMessage) []UnknownTag {
+	tags := make([]UnknownTag, len(fwm.UnknownTags))
+	copy(tags, fwm.UnknownTags)
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].Position < tags[j].Position
+	})
+	return tags
+}
@@ -0,0 +1,105 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+)
+
+// mockUnknownTagsMessage returns the sample CustomerTransfer with proprietary tags after {3320} and at the end
+func mockUnknownTagsMessage(t *testing.T) string {
+	lines := readCustomerTransfer(t)
+	lines = append(lines[:8], append([]string{"{3330}Proprietary*Value*"}, lines[8:]...)...)
+	lines = append(lines, "{9999}Trailer")
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func TestUnknownTag__roundTrip(t *testing.T) {
+	input := mockUnknownTagsMessage(t)
+
+	if _, err := NewReader(strings.NewReader(input)).Read(); !base.Has(err, NewErrInvalidTag("{3330}")) {
+		t.Fatalf("expected ErrInvalidTag: %v", err)
+	}
+
+	file, err := NewReader(strings.NewReader(input), PreserveUnknownTags(true)).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags := file.FEDWireMessages[0].UnknownTags
+	if len(tags) != 2 {
+		t.Fatalf("unexpected UnknownTags: %#v", tags)
+	}
+	if tags[0] != (UnknownTag{Tag: "{3330}", Value: "Proprietary*Value*", Position: 8}) {
+		t.Errorf("unexpected UnknownTag: %#v", tags[0])
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).Write(&file); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != input {
+		t.Errorf("unknown tags were not written in place:\n%s", buf.String())
+	}
+}
+
+func TestUnknownTag__JSON(t *testing.T) {
+	file, err := NewReader(strings.NewReader(mockUnknownTagsMessage(t)), PreserveUnknownTags(true)).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := json.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(bs, []byte(`"unknownTags":[{"tag":"{3330}","value":"Proprietary*Value*","position":8}`)) {
+		t.Errorf("unexpected JSON: %s", bs)
+	}
+	read, err := FileFromJSON(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(read.FEDWireMessages[0].UnknownTags); n != 2 {
+		t.Errorf("read %d UnknownTags", n)
+	}
+}
+
+func TestUnknownTag__stripOutputTags(t *testing.T) {
+	input := mockUnknownTagsMessage(t)
+	file, err := NewReader(strings.NewReader("{1100}30P 2\n"+input), PreserveUnknownTags(true)).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := NewWriter(&buf, StripOutputTags(true)).Write(&file); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != input {
+		t.Errorf("unknown tags were not written in place:\n%s", buf.String())
+	}
+}
+
+func TestUnknownTag__Validate(t *testing.T) {
+	for _, ut := range []UnknownTag{
+		{Tag: TagSenderReference, Value: "Sender Reference"},
+		{Tag: "3330", Value: "Proprietary"},
+		{Tag: "{3330}", Value: "Line One\n{3320}Line Two"},
+	} {
+		fwm := mockCustomerTransferData()
+		fwm.UnknownTags = []UnknownTag{{Tag: "{7777}"}, ut}
+		if err := fwm.isUnknownTagsValid(); err == nil {
+			t.Errorf("expected error: %#v", ut)
+		}
+	}
+	fwm := mockCustomerTransferData()
+	fwm.UnknownTags = []UnknownTag{{Tag: "{7777}", Value: "Proprietary"}}
+	if err := fwm.isUnknownTagsValid(); err != nil {
+		t.Error(err)
+	}
+}
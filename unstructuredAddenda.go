@@ -32,6 +32,11 @@ func NewUnstructuredAddenda() *UnstructuredAddenda {
 	return ua
 }
 
+// setTag sets the tag of UnstructuredAddenda, which JSON does not hold
+func (ua *UnstructuredAddenda) setTag() {
+	ua.tag = TagUnstructuredAddenda
+}
+
 // Parse takes the input string and parses the UnstructuredAddenda values
 //
 // Parse provides no guarantee about all fields being filled in. Callers should make a Validate() call to confirm
@@ -0,0 +1,209 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// ValidationError describes a single problem found when validating a FEDWireMessage
+type ValidationError struct {
+	// MessageIndex is the index of the FEDWireMessage within the File
+	MessageIndex int `json:"messageIndex"`
+	// Tag is the tag with the problem, e.g. {3100}, or empty when the problem is not with a single tag
+	Tag string `json:"tag,omitempty"`
+	// Field is the name of the field with the problem, or empty when the problem is with the whole tag
+	Field string `json:"field,omitempty"`
+	// Value is the offending value
+	Value string `json:"value,omitempty"`
+	// Rule describes the rule which was broken
+	Rule string `json:"rule"`
+}
+
+func (e ValidationError) Error() string {
+	var parts []string
+	for _, part := range []string{e.Tag, e.Field, e.Value, e.Rule} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// ValidationErrors is every problem found when validating a File or FEDWireMessage
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i := range e {
+		msgs[i] = e[i].Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// add appends a ValidationError for each error in err, skipping duplicates
+func (e *ValidationErrors) add(index int, tag string, err error) {
+	if err == nil {
+		return
+	}
+	switch el := err.(type) {
+	case base.ErrorList:
+		for i := range el {
+			e.add(index, tag, el[i])
+		}
+		return
+	case ruleErrors:
+		for i := range el {
+			e.add(index, tag, el[i])
+		}
+		return
+	}
+	ve := newValidationError(tag, err)
+	ve.MessageIndex = index
+	for i := range *e {
+		if (*e)[i] == ve {
+			return
+		}
+	}
+	*e = append(*e, ve)
+}
+
+// newValidationError describes err, which was found validating tag. tag is empty for the errors of
+// FEDWireMessage rules, which name the tag in the error.
+func newValidationError(tag string, err error) ValidationError {
+	ve := ValidationError{
+		Tag:  tag,
+		Rule: err.Error(),
+	}
+	switch e := err.(type) {
+	case *FieldError:
+		ve.Field = e.FieldName
+		ve.Rule = e.Err.Error()
+		if e.Value != nil {
+			ve.Value = fmt.Sprintf("%v", e.Value)
+		}
+		switch inner := e.Err.(type) {
+		case ErrBusinessFunctionCodeProperty:
+			ve.Value = inner.PropertyValue
+		case ErrInvalidPropertyForProperty:
+			ve.Value = inner.PropertyValue
+		}
+	case ErrBusinessFunctionCodeProperty:
+		ve.Field = e.Property
+		ve.Value = e.PropertyValue
+	case ErrInvalidPropertyForProperty:
+		ve.Field = e.Property
+		ve.Value = e.PropertyValue
+	case ErrRemittanceConflict:
+		ve.Field = e.Property
+		ve.Value = e.PropertyValue
+	}
+	if ve.Tag == "" {
+		// FEDWireMessage rules name the tag as the field, e.g. BusinessFunctionCode.TransactionTypeCode
+		name, field := ve.Field, ""
+		if i := strings.Index(name, "."); i > 0 {
+			name, field = name[:i], name[i+1:]
+		}
+		if t := tagForName(name); t != "" {
+			ve.Tag, ve.Field = t, field
+		}
+	}
+	return ve
+}
+
+// ValidateAll validates every tag of the FEDWireMessage and every FEDWireMessage rule, returning every
+// problem found rather than the first. FEDWireMessage rules are only checked once the mandatory tags are defined.
+//
+// Each tag is checked with its Validate, which stops at the first invalid field, so a tag reports at most one
+// problem: a tag with several invalid fields takes one fix and revalidation per field. Problems with different
+// tags, and with different FEDWireMessage rules, are all reported at once.
+func (fwm *FEDWireMessage) ValidateAll() ValidationErrors {
+	return fwm.validateAll(0)
+}
+
+func (fwm *FEDWireMessage) validateAll(index int) ValidationErrors {
+	var errs ValidationErrors
+	for i := range messageTags {
+		tag := messageTags[i].Tag
+		if v, ok := fwm.tagValue(tag).(interface{ Validate() error }); ok {
+			errs.add(index, tag, v.Validate())
+		}
+	}
+	if err := fwm.isMandatory(); err != nil {
+		errs.add(index, "", err)
+		return errs
+	}
+	for _, rule := range fwm.rules() {
+		errs.add(index, "", rule())
+	}
+	return errs
+}
+
+// ValidateAll validates every FEDWireMessage of the File, returning every problem found rather than the first.
+// As with FEDWireMessage.ValidateAll, each tag reports at most one problem.
+func (f *File) ValidateAll() ValidationErrors {
+	if len(f.FEDWireMessages) == 0 {
+		return ValidationErrors{{Rule: ErrFileNoFEDWireMessage.Error()}}
+	}
+	var errs ValidationErrors
+	for i := range f.FEDWireMessages {
+		errs = append(errs, f.FEDWireMessages[i].validateAll(i)...)
+		if f.routingDirectory != nil {
+			errs.add(i, "", f.FEDWireMessages[i].isParticipant(f.routingDirectory))
+		}
+	}
+	return errs
+}
+
+// ruleErrors accumulates the errors found by a FEDWireMessage rule. It unwraps to the first error found so
+// callers matching a single error, e.g. with base.Match, see the first failure.
+type ruleErrors []error
+
+// add appends err, when it is not nil, flattening a base.ErrorList or ruleErrors
+func (e *ruleErrors) add(err error) {
+	switch el := err.(type) {
+	case nil:
+	case base.ErrorList:
+		*e = append(*e, el...)
+	case ruleErrors:
+		*e = append(*e, el...)
+	default:
+		*e = append(*e, err)
+	}
+}
+
+// err returns nil when no errors were found, the error when one was found or every error found.
+func (e ruleErrors) err() error {
+	switch len(e) {
+	case 0:
+		return nil
+	case 1:
+		return e[0]
+	}
+	return e
+}
+
+func (e ruleErrors) Error() string {
+	return base.ErrorList(e).Error()
+}
+
+// Unwrap returns the first error found
+func (e ruleErrors) Unwrap() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e[0]
+}
+
+// firstError returns the first error found by a FEDWireMessage rule, or err itself
+func firstError(err error) error {
+	if el, ok := err.(ruleErrors); ok && len(el) > 0 {
+		return el[0]
+	}
+	return err
+}
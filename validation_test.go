@@ -0,0 +1,123 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestValidateAll validates every problem with a FEDWireMessage is reported
+func TestValidateAll(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.SenderDepositoryInstitution.SenderABANumber = "12104288Z"
+	fwm.Amount.Amount = "00000000000Z"
+	fwm.BusinessFunctionCode.TransactionTypeCode = "ZZZ"
+
+	errs := fwm.ValidateAll()
+	for _, want := range []ValidationError{
+		{Tag: TagAmount, Field: "Amount", Value: "00000000000Z", Rule: ErrNonAmount.Error()},
+		{Tag: TagSenderDepositoryInstitution, Field: "SenderABANumber", Value: "12104288Z", Rule: ErrNonNumeric.Error()},
+		{Tag: TagBusinessFunctionCode, Field: "TransactionTypeCode", Value: "ZZZ", Rule: ErrTransactionTypeCode.Error()},
+		{Tag: TagBeneficiary, Rule: ErrFieldRequired.Error()},
+	} {
+		found := false
+		for i := range errs {
+			if errs[i] == want {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("missing %#v in\n%s", want, errs)
+		}
+	}
+}
+
+// TestValidateAllFirstProblemPerTag validates a tag with several invalid fields reports only its first
+// problem, and the next once the first is fixed
+func TestValidateAllFirstProblemPerTag(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.SenderDepositoryInstitution.SenderABANumber = "12104288Z"
+	fwm.SenderDepositoryInstitution.SenderShortName = "Wells Fargo\x00"
+
+	var found []ValidationError
+	for _, ve := range fwm.ValidateAll() {
+		if ve.Tag == TagSenderDepositoryInstitution {
+			found = append(found, ve)
+		}
+	}
+	if len(found) != 1 || found[0].Field != "SenderABANumber" {
+		t.Fatalf("unexpected errors %v", found)
+	}
+
+	fwm.SenderDepositoryInstitution.SenderABANumber = "121042882"
+	found = nil
+	for _, ve := range fwm.ValidateAll() {
+		if ve.Tag == TagSenderDepositoryInstitution {
+			found = append(found, ve)
+		}
+	}
+	if len(found) != 1 || found[0].Field != "SenderShortName" {
+		t.Errorf("unexpected errors %v", found)
+	}
+}
+
+// TestValidateAllMandatory validates every missing mandatory tag is reported
+func TestValidateAllMandatory(t *testing.T) {
+	fwm := NewFEDWireMessage()
+	errs := fwm.ValidateAll()
+	if len(errs) != 7 {
+		t.Fatalf("got %d errors:\n%s", len(errs), errs)
+	}
+	if errs[0].Tag != TagSenderSupplied || errs[0].Rule != ErrFieldRequired.Error() {
+		t.Errorf("unexpected error %#v", errs[0])
+	}
+}
+
+// TestValidateAllBusinessFunctionCodes validates each BusinessFunctionCode with only the mandatory tags
+// is checked without failing on the tags which are not defined
+func TestValidateAllBusinessFunctionCodes(t *testing.T) {
+	for _, code := range []string{
+		BankTransfer, CheckSameDaySettlement, CustomerTransferPlus, CustomerTransfer, DepositSendersAccount,
+		BankDrawDownRequest, CustomerCorporateDrawdownRequest, DrawDownRequest, FEDFundsReturned, FEDFundsSold,
+		BFCServiceMessage,
+	} {
+		fwm := mockCustomerTransferData()
+		fwm.BusinessFunctionCode.BusinessFunctionCode = code
+		fwm.ValidateAll()
+
+		fwm.LocalInstrument = mockLocalInstrument()
+		fwm.LocalInstrument.LocalInstrumentCode = RemittanceInformationStructured
+		fwm.ValidateAll()
+	}
+}
+
+// TestValidateAllFile validates the test files report no problems and problems are reported with
+// the index of the FEDWireMessage
+func TestValidateAllFile(t *testing.T) {
+	fd, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-MultipleMessages.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	file, err := NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := file.ValidateAll(); len(errs) != 0 {
+		t.Fatalf("unexpected errors:\n%s", errs)
+	}
+
+	file.FEDWireMessages[1].Amount.Amount = "00000000000Z"
+	errs := file.ValidateAll()
+	if len(errs) != 1 || errs[0].MessageIndex != 1 || errs[0].Tag != TagAmount {
+		t.Errorf("unexpected errors: %#v", errs)
+	}
+
+	if errs := NewFile().ValidateAll(); len(errs) != 1 || errs[0].Rule != ErrFileNoFEDWireMessage.Error() {
+		t.Errorf("unexpected errors: %#v", errs)
+	}
+}
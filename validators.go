@@ -10,6 +10,7 @@ import (
 	"unicode/utf8"
 
 	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
 )
 
 var (
@@ -17,6 +18,8 @@ var (
 	alphanumericRegex = regexp.MustCompile(`[^ \w!"#$%&'()*+,-.\\/:;<>=?@\[\]^_{}|~]+`)
 	numericRegex      = regexp.MustCompile(`[^0-9]`)
 	amountRegex       = regexp.MustCompile("[^0-9,.]")
+	bicRegex          = regexp.MustCompile(`^[A-Z]{4}([A-Z]{2})[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	ibanRegex         = regexp.MustCompile(`^([A-Z]{2})[0-9]{2}[A-Z0-9]{11,30}$`)
 )
 
 // validator is common validation and formatting of golang types to WIRE type strings
@@ -40,6 +43,116 @@ func (v *validator) isNumeric(s string) error {
 	return nil
 }
 
+// routingNumberWeights are the weights of each digit of an ABA routing number, whose weighted sum is a multiple
+// of 10
+var routingNumberWeights = [9]int{3, 7, 1, 3, 7, 1, 3, 7, 1}
+
+// isRoutingNumber checks if a string is a nine digit ABA routing number with a valid check digit. Whether it is an
+// active Fedwire participant is checked by a File with a RoutingDirectory.
+func (v *validator) isRoutingNumber(s string) error {
+	if err := v.isNumeric(s); err != nil {
+		return err
+	}
+	if len(s) != len(routingNumberWeights) {
+		return ErrRoutingNumber
+	}
+	sum := 0
+	for i, weight := range routingNumberWeights {
+		sum += int(s[i]-'0') * weight
+	}
+	if sum%10 != 0 {
+		return ErrRoutingNumber
+	}
+	return nil
+}
+
+// isIdentifier checks the identifier of an identification code: a FEDRoutingNumber is a routing number, a
+// SWIFTBankIdentifierCode is a BIC, a SWIFTBICORBEIANDAccountNumber is a BIC followed by an account number and a
+// DemandDepositAccountNumber which looks like an IBAN is an IBAN. Other identifiers are not checked.
+func (v *validator) isIdentifier(code, identifier string) error {
+	switch code {
+	case FEDRoutingNumber:
+		return v.isRoutingNumber(identifier)
+	case SWIFTBankIdentifierCode:
+		return v.isBIC(identifier)
+	case SWIFTBICORBEIANDAccountNumber:
+		return v.isBICAndAccountNumber(identifier)
+	case DemandDepositAccountNumber:
+		return v.isAccountNumber(identifier)
+	}
+	return nil
+}
+
+// isBIC checks if a string is a SWIFT Bank Identifier Code (BIC): a four letter institution code, an ISO 3166
+// country code, a two character location code and an optional three character branch code
+func (v *validator) isBIC(s string) error {
+	m := bicRegex.FindStringSubmatch(s)
+	if m == nil || !isCountryCode(m[1]) {
+		return ErrBIC
+	}
+	if branch := m[2]; branch != "" && branch[0] == 'X' && branch != "XXX" {
+		// branch codes beginning with X are reserved, except XXX for the primary office
+		return ErrBIC
+	}
+	return nil
+}
+
+// isBICAndAccountNumber checks if a string is a BIC followed by an account number, separated by a slash or,
+// when there is no slash, in the characters following an eleven character BIC. An account number which looks
+// like an IBAN is checked as one.
+func (v *validator) isBICAndAccountNumber(s string) error {
+	bic, account := s, ""
+	if i := strings.Index(s, "/"); i >= 0 {
+		bic, account = s[:i], s[i+1:]
+	} else if len(s) > 11 {
+		bic, account = s[:11], s[11:]
+	}
+	if err := v.isBIC(bic); err != nil {
+		return err
+	}
+	return v.isAccountNumber(account)
+}
+
+// isAccountNumber checks an account number which looks like an International Bank Account Number (IBAN), two
+// letters of a country code and two check digits, is an IBAN. Other account numbers are not checked.
+func (v *validator) isAccountNumber(s string) error {
+	iban := strings.ReplaceAll(s, " ", "")
+	m := ibanRegex.FindStringSubmatch(iban)
+	if m == nil || !isCountryCode(m[1]) {
+		return nil
+	}
+	return v.isIBAN(iban)
+}
+
+// isIBAN checks if a string, without spaces, passes the ISO 13616 mod-97 check of an International Bank
+// Account Number (IBAN)
+func (v *validator) isIBAN(s string) error {
+	if len(s) < 5 {
+		return ErrIBAN
+	}
+	remainder := 0
+	for _, r := range s[4:] + s[:4] {
+		switch {
+		case r >= '0' && r <= '9':
+			remainder = (remainder*10 + int(r-'0')) % 97
+		case r >= 'A' && r <= 'Z':
+			remainder = (remainder*100 + int(r-'A') + 10) % 97
+		default:
+			return ErrIBAN
+		}
+	}
+	if remainder != 1 {
+		return ErrIBAN
+	}
+	return nil
+}
+
+// isCountryCode returns true when code is an ISO 3166 country code
+func isCountryCode(code string) bool {
+	region, err := language.ParseRegion(code)
+	return err == nil && region.IsCountry()
+}
+
 // ToDo: Amount Decimal and AmountComma (only 1 per each) ?
 
 // isAmount checks if a string only contains onc comma and ASCII numeric (0-9) characters
@@ -143,6 +256,43 @@ func (v *validator) isMessageDuplicationCode(code string) error {
 	return ErrMessageDuplicationCode
 }
 
+// isDispositionDuplicationCode validates the MessageDuplicationCode of MessageDisposition {1100}, which may also
+// designate the retrieval of an original message
+func (v *validator) isDispositionDuplicationCode(code string) error {
+	if code == MessageDuplicationRetrieval {
+		return nil
+	}
+	return v.isMessageDuplicationCode(code)
+}
+
+func (v *validator) isMessageStatusIndicator(code string) error {
+	switch code {
+	case
+		MessageStatusInProcess,
+		MessageStatusSuccessfulValue,
+		MessageStatusRejected,
+		MessageStatusSuccessfulNonValue,
+		MessageStatusIncomingValue,
+		MessageStatusIncomingNonValue:
+		return nil
+	}
+	return ErrMessageStatusIndicator
+}
+
+func (v *validator) isErrorCategory(code string) error {
+	switch code {
+	case
+		ErrorCategoryDataError,
+		ErrorCategoryInsufficientBalance,
+		ErrorCategoryAccountabilityError,
+		ErrorCategoryInProcess,
+		ErrorCategoryCutoffHour,
+		ErrorCategoryDuplicateIMAD:
+		return nil
+	}
+	return ErrErrorCategory
+}
+
 func (v *validator) isBusinessFunctionCode(code string) error {
 	switch code {
 	case
@@ -439,6 +589,34 @@ func (v *validator) validateDate(s string) error {
 	return nil
 }
 
+// validateMonthDay validates a MMDD calendar date
+func (v *validator) validateMonthDay(s string) error {
+	if length := utf8.RuneCountInString(s); length != 4 {
+		return NewTagWrongLengthErr(4, len(s))
+	}
+	if err := v.isMonth(s[:2]); err != nil {
+		return ErrValidDate
+	}
+	if err := v.isDay(s[:2], s[2:]); err != nil {
+		return ErrValidDate
+	}
+	return nil
+}
+
+// validateTime validates a HHMM time on a 24-hour clock
+func (v *validator) validateTime(s string) error {
+	if length := utf8.RuneCountInString(s); length != 4 {
+		return NewTagWrongLengthErr(4, len(s))
+	}
+	if err := v.isNumeric(s); err != nil {
+		return ErrValidTime
+	}
+	if s[:2] > "23" || s[2:] > "59" {
+		return ErrValidTime
+	}
+	return nil
+}
+
 // validatePartyIdentifier validates OriginatorOptionF PartyIdentifier
 // PartyIdentifier must be one of the following two formats:
 // 1. /Account Number (slash followed by at least one
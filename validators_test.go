@@ -26,3 +26,72 @@ func TestValidators__validateOptionFName(t *testing.T) {
 		t.Error("expected error")
 	}
 }
+
+func TestValidators__isRoutingNumber(t *testing.T) {
+	v := &validator{}
+	for _, rtn := range []string{"121042882", "231380104", "011000015"} {
+		if err := v.isRoutingNumber(rtn); err != nil {
+			t.Errorf("%s: %v", rtn, err)
+		}
+	}
+	for _, rtn := range []string{"121042883", "12104288", "1210428820"} {
+		if err := v.isRoutingNumber(rtn); err != ErrRoutingNumber {
+			t.Errorf("%s: %v", rtn, err)
+		}
+	}
+	if err := v.isRoutingNumber("12104288A"); err != ErrNonNumeric {
+		t.Errorf("%v", err)
+	}
+}
+
+func TestValidators__isBIC(t *testing.T) {
+	v := &validator{}
+	for _, bic := range []string{"CITIUS33", "CITIUS33XXX", "DEUTDEFF500", "MOOVUS3A"} {
+		if err := v.isBIC(bic); err != nil {
+			t.Errorf("%s: %v", bic, err)
+		}
+	}
+	for _, bic := range []string{"CITIUS3", "CITIUS33XX", "CITIZZ33", "C1TIUS33", "citius33", "CITIUS33X12", "CITIUS33-XX"} {
+		if err := v.isBIC(bic); err != ErrBIC {
+			t.Errorf("%s: %v", bic, err)
+		}
+	}
+}
+
+func TestValidators__isIBAN(t *testing.T) {
+	v := &validator{}
+	for _, iban := range []string{"DE89370400440532013000", "GB82WEST12345698765432", "GB82 WEST 1234 5698 7654 32"} {
+		if err := v.isAccountNumber(iban); err != nil {
+			t.Errorf("%s: %v", iban, err)
+		}
+	}
+	for _, iban := range []string{"DE89370400440532013001", "GB82WEST12345698765433"} {
+		if err := v.isAccountNumber(iban); err != ErrIBAN {
+			t.Errorf("%s: %v", iban, err)
+		}
+	}
+	// account numbers which do not look like an IBAN are not checked
+	for _, account := range []string{"123456789", "ZZ89370400440532013001", "DE8937"} {
+		if err := v.isAccountNumber(account); err != nil {
+			t.Errorf("%s: %v", account, err)
+		}
+	}
+}
+
+func TestValidators__isBICAndAccountNumber(t *testing.T) {
+	v := &validator{}
+	for _, s := range []string{"CITIUS33/123456789", "DEUTDEFF/DE89370400440532013000", "DEUTDEFFXXXDE89370400440532013000", "CITIUS33XXX"} {
+		if err := v.isIdentifier(SWIFTBICORBEIANDAccountNumber, s); err != nil {
+			t.Errorf("%s: %v", s, err)
+		}
+	}
+	if err := v.isIdentifier(SWIFTBICORBEIANDAccountNumber, "CITIUS/123456789"); err != ErrBIC {
+		t.Errorf("%v", err)
+	}
+	if err := v.isIdentifier(SWIFTBICORBEIANDAccountNumber, "DEUTDEFF/DE89370400440532013001"); err != ErrIBAN {
+		t.Errorf("%v", err)
+	}
+	if err := v.isIdentifier(CHIPSParticipant, "not checked"); err != nil {
+		t.Errorf("%v", err)
+	}
+}
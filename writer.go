@@ -16,15 +16,46 @@ import (
 
 // Writer struct
 type Writer struct {
-	w       *bufio.Writer
-	lineNum int //current line being written
+	w              *bufio.Writer
+	lineNum        int  //current line being written
+	variableLength bool // write tags in the delimited format
+	// stripOutputTags skips the tags appended by the Fedwire Funds Service
+	stripOutputTags bool
+	// unknownTags are the UnknownTags of the FEDWireMessage being written which are yet to be written
+	unknownTags []UnknownTag
+	// position is the number of tags of the FEDWireMessage being written which precede the next tag
+	position int
+}
+
+// OptionFunc configures a Writer
+type OptionFunc func(*Writer)
+
+// VariableLengthFields writes variable length elements in the delimited format, where each element has its
+// trailing spaces removed and is terminated by a Delimiter, rather than being space filled to its full width.
+func VariableLengthFields(variable bool) OptionFunc {
+	return func(w *Writer) {
+		w.variableLength = variable
+	}
+}
+
+// StripOutputTags skips MessageDisposition {1100}, ReceiptTimeStamp {1110}, OutputMessageAccountabilityData
+// {1120} and ErrorWire {1130}, which the Fedwire Funds Service appends to output messages, so a received
+// FEDWireMessage can be written for submission. They are written ahead of SenderSupplied {1500} otherwise.
+func StripOutputTags(strip bool) OptionFunc {
+	return func(w *Writer) {
+		w.stripOutputTags = strip
+	}
 }
 
 // NewWriter returns a new Writer that writes to w.
-func NewWriter(w io.Writer) *Writer {
-	return &Writer{
+func NewWriter(w io.Writer, opts ...OptionFunc) *Writer {
+	writer := &Writer{
 		w: bufio.NewWriter(w),
 	}
+	for _, opt := range opts {
+		opt(writer)
+	}
+	return writer
 }
 
 // Writer writes a single FEDWireMessage record to w
@@ -34,10 +65,12 @@ func (w *Writer) Write(file *File) error {
 	}
 	w.lineNum = 0
 	// Iterate over all records in the file
-	if err := w.writeFEDWireMessage(file); err != nil {
-		return err
+	for i := range file.FEDWireMessages {
+		if err := w.writeFEDWireMessage(file.FEDWireMessages[i]); err != nil {
+			return err
+		}
+		w.lineNum++
 	}
-	w.lineNum++
 
 	return w.w.Flush()
 }
@@ -49,8 +82,48 @@ func (w *Writer) Flush() error {
 	return w.w.Flush()
 }
 
-func (w *Writer) writeFEDWireMessage(file *File) error {
-	fwm := file.FEDWireMessage
+// format returns record in the format configured for w
+func (w *Writer) format(record string) string {
+	if w.variableLength {
+		return delimit(record)
+	}
+	return record
+}
+
+// writeRecord writes record in the format configured for w, preceded by any UnknownTags positioned before it
+func (w *Writer) writeRecord(record string) error {
+	if err := w.writeUnknownTags(w.position); err != nil {
+		return err
+	}
+	w.position++
+	_, err := w.w.WriteString(w.format(record) + "\n")
+	return err
+}
+
+// writeUnknownTags writes the UnknownTags yet to be written whose Position is no more than position, verbatim
+func (w *Writer) writeUnknownTags(position int) error {
+	for len(w.unknownTags) > 0 && w.unknownTags[0].Position <= position {
+		if _, err := w.w.WriteString(w.unknownTags[0].String() + "\n"); err != nil {
+			return err
+		}
+		w.unknownTags = w.unknownTags[1:]
+		w.position++
+		position++
+	}
+	return nil
+}
+
+func (w *Writer) writeFEDWireMessage(fwm FEDWireMessage) error {
+	w.unknownTags = sortedUnknownTags(fwm)
+	w.position = 0
+	if !w.stripOutputTags {
+		if err := w.writeOutputTags(fwm); err != nil {
+			return err
+		}
+	} else {
+		// the positions of UnknownTags count the stripped tags
+		w.position = countOutputTags(fwm)
+	}
 	if err := w.writeMandatory(fwm); err != nil {
 		return err
 	}
@@ -72,7 +145,7 @@ func (w *Writer) writeFEDWireMessage(file *File) error {
 	}
 
 	if fwm.UnstructuredAddenda != nil {
-		if _, err := w.w.WriteString(fwm.GetUnstructuredAddenda().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetUnstructuredAddenda().String()); err != nil {
 			return err
 		}
 	}
@@ -80,38 +153,57 @@ func (w *Writer) writeFEDWireMessage(file *File) error {
 		return err
 	}
 	if fwm.ServiceMessage != nil {
-		if _, err := w.w.WriteString(fwm.GetServiceMessage().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetServiceMessage().String()); err != nil {
 			return err
 		}
 	}
+	// UnknownTags positioned after the last tag
+	if len(w.unknownTags) > 0 {
+		return w.writeUnknownTags(w.unknownTags[len(w.unknownTags)-1].Position)
+	}
+	return nil
+}
+
+// countOutputTags returns the number of tags appended by the Fedwire Funds Service which fwm has
+func countOutputTags(fwm FEDWireMessage) int {
+	n := 0
+	for _, defined := range []bool{fwm.MessageDisposition != nil, fwm.ReceiptTimeStamp != nil,
+		fwm.OutputMessageAccountabilityData != nil, fwm.ErrorWire != nil} {
+		if defined {
+			n++
+		}
+	}
+	return n
+}
 
-	// Information Appended by FedWire Funds Service - Commented for now
-	/*	if fwm.MessageDisposition != nil {
-		if _, err := w.w.WriteString(fwm.GetMessageDisposition().String() + "\n"); err != nil {
+// writeOutputTags writes the tags appended by the Fedwire Funds Service, which precede SenderSupplied {1500}
+func (w *Writer) writeOutputTags(fwm FEDWireMessage) error {
+	if fwm.MessageDisposition != nil {
+		if err := w.writeRecord(fwm.GetMessageDisposition().String()); err != nil {
 			return err
 		}
-	}*/
-	/*	if fwm.ReceiptTimeStamp != nil {
-		if _, err := w.w.WriteString(fwm.GetReceiptTimeStamp().String() + "\n"); err != nil {
+	}
+	if fwm.ReceiptTimeStamp != nil {
+		if err := w.writeRecord(fwm.GetReceiptTimeStamp().String()); err != nil {
 			return err
 		}
-	}*/
-	/*	if fwm.OutputMessageAccountabilityData != nil {
-		if _, err := w.w.WriteString(fwm.GetOutputMessageAccountabilityData().String() + "\n"); err != nil {
+	}
+	if fwm.OutputMessageAccountabilityData != nil {
+		if err := w.writeRecord(fwm.GetOutputMessageAccountabilityData().String()); err != nil {
 			return err
 		}
-	}*/
-	/*	if fwm.ErrorWire != nil {
-		if _, err := w.w.WriteString(fwm.GetErrorWire().String() + "\n"); err != nil {
+	}
+	if fwm.ErrorWire != nil {
+		if err := w.writeRecord(fwm.GetErrorWire().String()); err != nil {
 			return err
 		}
-	}*/
+	}
 	return nil
 }
 
 func (w *Writer) writeMandatory(fwm FEDWireMessage) error {
 	if fwm.SenderSupplied != nil {
-		if _, err := w.w.WriteString(fwm.GetSenderSupplied().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetSenderSupplied().String()); err != nil {
 			return err
 		}
 	} else {
@@ -119,42 +211,42 @@ func (w *Writer) writeMandatory(fwm FEDWireMessage) error {
 	}
 
 	if fwm.TypeSubType != nil {
-		if _, err := w.w.WriteString(fwm.GetTypeSubType().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetTypeSubType().String()); err != nil {
 			return err
 		}
 	} else {
 		return fieldError("TypeSubType", ErrFieldRequired)
 	}
 	if fwm.InputMessageAccountabilityData != nil {
-		if _, err := w.w.WriteString(fwm.GetInputMessageAccountabilityData().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetInputMessageAccountabilityData().String()); err != nil {
 			return err
 		}
 	} else {
 		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
 	}
 	if fwm.Amount != nil {
-		if _, err := w.w.WriteString(fwm.GetAmount().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetAmount().String()); err != nil {
 			return err
 		}
 	} else {
 		return fieldError("Amount", ErrFieldRequired)
 	}
 	if fwm.SenderDepositoryInstitution != nil {
-		if _, err := w.w.WriteString(fwm.GetSenderDepositoryInstitution().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetSenderDepositoryInstitution().String()); err != nil {
 			return err
 		}
 	} else {
 		return fieldError("SenderDepositoryInstitution", ErrFieldRequired)
 	}
 	if fwm.ReceiverDepositoryInstitution != nil {
-		if _, err := w.w.WriteString(fwm.GetReceiverDepositoryInstitution().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetReceiverDepositoryInstitution().String()); err != nil {
 			return err
 		}
 	} else {
 		return fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
 	}
 	if fwm.BusinessFunctionCode != nil {
-		if _, err := w.w.WriteString(fwm.GetBusinessFunctionCode().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetBusinessFunctionCode().String()); err != nil {
 			return err
 		}
 	} else {
@@ -165,37 +257,37 @@ func (w *Writer) writeMandatory(fwm FEDWireMessage) error {
 
 func (w *Writer) writeOtherTransferInfo(fwm FEDWireMessage) error {
 	if fwm.SenderReference != nil {
-		if _, err := w.w.WriteString(fwm.GetSenderReference().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetSenderReference().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.PreviousMessageIdentifier != nil {
-		if _, err := w.w.WriteString(fwm.GetPreviousMessageIdentifier().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetPreviousMessageIdentifier().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.LocalInstrument != nil {
-		if _, err := w.w.WriteString(fwm.GetLocalInstrument().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetLocalInstrument().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.PaymentNotification != nil {
-		if _, err := w.w.WriteString(fwm.GetPaymentNotification().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetPaymentNotification().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.Charges != nil {
-		if _, err := w.w.WriteString(fwm.GetCharges().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetCharges().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.InstructedAmount != nil {
-		if _, err := w.w.WriteString(fwm.GetInstructedAmount().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetInstructedAmount().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.ExchangeRate != nil {
-		if _, err := w.w.WriteString(fwm.GetExchangeRate().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetExchangeRate().String()); err != nil {
 			return err
 		}
 	}
@@ -204,34 +296,34 @@ func (w *Writer) writeOtherTransferInfo(fwm FEDWireMessage) error {
 
 func (w *Writer) writeBeneficiary(fwm FEDWireMessage) error {
 	if fwm.BeneficiaryIntermediaryFI != nil {
-		if _, err := w.w.WriteString(fwm.GetBeneficiaryIntermediaryFI().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetBeneficiaryIntermediaryFI().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.BeneficiaryFI != nil {
 		if fwm.BeneficiaryFI != nil {
-			if _, err := w.w.WriteString(fwm.GetBeneficiaryFI().String() + "\n"); err != nil {
+			if err := w.writeRecord(fwm.GetBeneficiaryFI().String()); err != nil {
 				return err
 			}
 		}
 	}
 	if fwm.Beneficiary != nil {
 		if fwm.Beneficiary != nil {
-			if _, err := w.w.WriteString(fwm.GetBeneficiary().String() + "\n"); err != nil {
+			if err := w.writeRecord(fwm.GetBeneficiary().String()); err != nil {
 				return err
 			}
 		}
 	}
 	if fwm.BeneficiaryReference != nil {
 		if fwm.BeneficiaryReference != nil {
-			if _, err := w.w.WriteString(fwm.GetBeneficiaryReference().String() + "\n"); err != nil {
+			if err := w.writeRecord(fwm.GetBeneficiaryReference().String()); err != nil {
 				return err
 			}
 		}
 	}
 	if fwm.AccountDebitedDrawdown != nil {
 		if fwm.AccountDebitedDrawdown != nil {
-			if _, err := w.w.WriteString(fwm.GetAccountDebitedDrawdown().String() + "\n"); err != nil {
+			if err := w.writeRecord(fwm.GetAccountDebitedDrawdown().String()); err != nil {
 				return err
 			}
 		}
@@ -241,32 +333,32 @@ func (w *Writer) writeBeneficiary(fwm FEDWireMessage) error {
 
 func (w *Writer) writeOriginator(fwm FEDWireMessage) error {
 	if fwm.Originator != nil {
-		if _, err := w.w.WriteString(fwm.GetOriginator().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetOriginator().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.OriginatorOptionF != nil {
-		if _, err := w.w.WriteString(fwm.GetOriginatorOptionF().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetOriginatorOptionF().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.OriginatorFI != nil {
-		if _, err := w.w.WriteString(fwm.GetOriginatorFI().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetOriginatorFI().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.InstructingFI != nil {
-		if _, err := w.w.WriteString(fwm.GetInstructingFI().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetInstructingFI().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.AccountCreditedDrawdown != nil {
-		if _, err := w.w.WriteString(fwm.GetAccountCreditedDrawdown().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetAccountCreditedDrawdown().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.OriginatorToBeneficiary != nil {
-		if _, err := w.w.WriteString(fwm.GetOriginatorToBeneficiary().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetOriginatorToBeneficiary().String()); err != nil {
 			return err
 		}
 	}
@@ -275,52 +367,52 @@ func (w *Writer) writeOriginator(fwm FEDWireMessage) error {
 
 func (w *Writer) writeFinancialInstitution(fwm FEDWireMessage) error {
 	if fwm.FIReceiverFI != nil {
-		if _, err := w.w.WriteString(fwm.GetFIReceiverFI().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetFIReceiverFI().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.FIDrawdownDebitAccountAdvice != nil {
-		if _, err := w.w.WriteString(fwm.GetFIDrawdownDebitAccountAdvice().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetFIDrawdownDebitAccountAdvice().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.FIIntermediaryFI != nil {
-		if _, err := w.w.WriteString(fwm.GetFIIntermediaryFI().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetFIIntermediaryFI().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.FIIntermediaryFIAdvice != nil {
-		if _, err := w.w.WriteString(fwm.GetFIIntermediaryFIAdvice().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetFIIntermediaryFIAdvice().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.FIBeneficiaryFI != nil {
-		if _, err := w.w.WriteString(fwm.GetFIBeneficiaryFI().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetFIBeneficiaryFI().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.FIBeneficiaryFIAdvice != nil {
-		if _, err := w.w.WriteString(fwm.GetFIBeneficiaryFIAdvice().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetFIBeneficiaryFIAdvice().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.FIBeneficiary != nil {
-		if _, err := w.w.WriteString(fwm.GetFIBeneficiary().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetFIBeneficiary().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.FIBeneficiaryAdvice != nil {
-		if _, err := w.w.WriteString(fwm.GetFIBeneficiaryAdvice().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetFIBeneficiaryAdvice().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.FIPaymentMethodToBeneficiary != nil {
-		if _, err := w.w.WriteString(fwm.GetFIPaymentMethodToBeneficiary().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetFIPaymentMethodToBeneficiary().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.FIAdditionalFIToFI != nil {
-		if _, err := w.w.WriteString(fwm.GetFIAdditionalFIToFI().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetFIAdditionalFIToFI().String()); err != nil {
 			return err
 		}
 	}
@@ -329,42 +421,42 @@ func (w *Writer) writeFinancialInstitution(fwm FEDWireMessage) error {
 
 func (w *Writer) writeCoverPayment(fwm FEDWireMessage) error {
 	if fwm.CurrencyInstructedAmount != nil {
-		if _, err := w.w.WriteString(fwm.GetCurrencyInstructedAmount().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetCurrencyInstructedAmount().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.OrderingCustomer != nil {
-		if _, err := w.w.WriteString(fwm.GetOrderingCustomer().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetOrderingCustomer().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.OrderingInstitution != nil {
-		if _, err := w.w.WriteString(fwm.GetOrderingInstitution().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetOrderingInstitution().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.IntermediaryInstitution != nil {
-		if _, err := w.w.WriteString(fwm.GetIntermediaryInstitution().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetIntermediaryInstitution().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.InstitutionAccount != nil {
-		if _, err := w.w.WriteString(fwm.GetInstitutionAccount().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetInstitutionAccount().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.BeneficiaryCustomer != nil {
-		if _, err := w.w.WriteString(fwm.GetBeneficiaryCustomer().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetBeneficiaryCustomer().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.Remittance != nil {
-		if _, err := w.w.WriteString(fwm.GetRemittance().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetRemittance().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.SenderToReceiver != nil {
-		if _, err := w.w.WriteString(fwm.GetSenderToReceiver().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetSenderToReceiver().String()); err != nil {
 			return err
 		}
 	}
@@ -375,58 +467,58 @@ func (w *Writer) writeRemittance(fwm FEDWireMessage) error {
 
 	// Related Remittance
 	if fwm.RelatedRemittance != nil {
-		if _, err := w.w.WriteString(fwm.GetRelatedRemittance().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetRelatedRemittance().String()); err != nil {
 			return err
 		}
 	}
 	// Structured Remittance
 	if fwm.RemittanceOriginator != nil {
-		if _, err := w.w.WriteString(fwm.GetRemittanceOriginator().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetRemittanceOriginator().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.RemittanceBeneficiary != nil {
-		if _, err := w.w.WriteString(fwm.GetRemittanceBeneficiary().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetRemittanceBeneficiary().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.PrimaryRemittanceDocument != nil {
-		if _, err := w.w.WriteString(fwm.GetPrimaryRemittanceDocument().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetPrimaryRemittanceDocument().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.ActualAmountPaid != nil {
-		if _, err := w.w.WriteString(fwm.GetActualAmountPaid().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetActualAmountPaid().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.GrossAmountRemittanceDocument != nil {
-		if _, err := w.w.WriteString(fwm.GetGrossAmountRemittanceDocument().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetGrossAmountRemittanceDocument().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.AmountNegotiatedDiscount != nil {
-		if _, err := w.w.WriteString(fwm.GetAmountNegotiatedDiscount().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetAmountNegotiatedDiscount().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.Adjustment != nil {
-		if _, err := w.w.WriteString(fwm.GetAdjustment().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetAdjustment().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.DateRemittanceDocument != nil {
-		if _, err := w.w.WriteString(fwm.GetDateRemittanceDocument().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetDateRemittanceDocument().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.SecondaryRemittanceDocument != nil {
-		if _, err := w.w.WriteString(fwm.GetSecondaryRemittanceDocument().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetSecondaryRemittanceDocument().String()); err != nil {
 			return err
 		}
 	}
 	if fwm.RemittanceFreeText != nil {
-		if _, err := w.w.WriteString(fwm.GetRemittanceFreeText().String() + "\n"); err != nil {
+		if err := w.writeRecord(fwm.GetRemittanceFreeText().String()); err != nil {
 			return err
 		}
 	}
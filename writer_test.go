@@ -2,9 +2,13 @@ package wire
 
 import (
 	"bytes"
-	"github.com/moov-io/base"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/moov-io/base"
 )
 
 /*// TestFEDWireMessageWriteCustomerTransfer writes a FEDWireMessage to a file with BusinessFunctionCode = CTR
@@ -1635,3 +1639,41 @@ func TestFEDWireMessageWriteCustomerTransferPlusUnstructuredAddenda(t *testing.T
 		t.Errorf("%T: %s", err, err)
 	}
 }
+
+// TestWriteOutputTags writes the output tags {1100}-{1130} of a FEDWireMessage ahead of SenderSupplied {1500},
+// or skips them with StripOutputTags
+func TestWriteOutputTags(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := "{1100}30P N\n{1110}04101230FT03\n{1120}20190410ABCD1234000001041012300FT1\n" +
+		fmt.Sprintf("{1130}E301%-35s\n", "Invalid amount")
+	file, err := NewReader(strings.NewReader(output + string(bs))).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).Write(&file); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), output+"{1500}") {
+		t.Errorf("unexpected output:\n%s", buf.String())
+	}
+	read, err := NewReader(strings.NewReader(buf.String())).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ew := read.FEDWireMessages[0].ErrorWire; ew == nil || ew.ErrorCode != "301" {
+		t.Errorf("unexpected ErrorWire: %#v", ew)
+	}
+
+	buf.Reset()
+	if err := NewWriter(&buf, StripOutputTags(true)).Write(&file); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "{11") || !strings.HasPrefix(buf.String(), "{1500}") {
+		t.Errorf("unexpected output:\n%s", buf.String())
+	}
+}